@@ -1,19 +1,26 @@
 package bootstrap
 
-import "fmt"
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
 
 type Constants struct {
-	Context             Context
-	LogLevel            LogLevel
-	RedisKey            RedisKey
-	S3BucketPath        BucketPath
-	Field               ErrorField
-	Tag                 ErrorTag
-	SMSTemplates        SMSTemplates
-	EmailTemplates      EmailTemplates
-	JWTKeysPath         JWTKeysPath
-	Metrics             Metrics
-	AddressOwners       AddressOwners
+	Context         Context
+	LogLevel        LogLevel
+	RedisKey        RedisKey
+	S3BucketPath    BucketPath
+	Field           ErrorField
+	Tag             ErrorTag
+	SMSTemplates    SMSTemplates
+	EmailTemplates  EmailTemplates
+	JWTKeysPath     JWTKeysPath
+	Metrics         Metrics
+	AddressOwners   AddressOwners
+	ImageRenditions ImageRenditions
+	StorageDrivers  StorageDrivers
+	Locales         Locales
 }
 
 type Context struct {
@@ -37,25 +44,45 @@ type BucketPath struct {
 }
 
 type ErrorField struct {
-	User                string
-	Phone               string
-	Email               string
-	Password            string
-	OTP                 string
-	NationalID          string
-	RegistrationNumber  string
-	IBAN                string
-	Address             string
-	Name                string
-	Province            string
-	City                string
-	Page                string
-	Role                string
-	Permission          string
-	News                string
-	Media               string
-	Post                string
-	Like                string
+	User               string
+	Phone              string
+	Email              string
+	Password           string
+	OTP                string
+	NationalID         string
+	RegistrationNumber string
+	IBAN               string
+	Address            string
+	Name               string
+	Province           string
+	City               string
+	Page               string
+	Role               string
+	Permission         string
+	News               string
+	Media              string
+	Post               string
+	Like               string
+	Referral           string
+	ReferralCode       string
+	GiftCard           string
+	Amount             string
+	Ingredient         string
+	INCIName           string
+	SkinType           string
+	SkinConcern        string
+	File               string
+	Upload             string
+	PublishAt          string
+	UnpublishAt        string
+	NewsCategory       string
+	NewsTag            string
+	Comment            string
+	Revision           string
+	NewsPreview        string
+	ExpiresInHours     string
+	Locale             string
+	NewsTranslation    string
 }
 
 type ErrorTag struct {
@@ -89,10 +116,22 @@ type ErrorTag struct {
 	AlreadyRejected        string
 	AlreadyAccepted        string
 	AlreadyDraft           string
+	ExpiredItem            string
+	InsufficientBalance    string
+	UnsupportedFileType    string
+	FileTooLarge           string
+	InvalidDimensions      string
+	MalwareDetected        string
+	FutureTime             string
+	AfterPublishTime       string
+	InvalidReply           string
+	AlreadyFlagged         string
+	ExceedsMaximum         string
 }
 
 type SMSTemplates struct {
-	OTP string
+	OTP      string
+	GiftCard string
 }
 
 type EmailTemplates struct {
@@ -109,6 +148,7 @@ type JWTKeysPath struct {
 type Metrics struct {
 	HTTPRequestsTotal   Options
 	HTTPRequestDuration Options
+	BusinessEventsTotal Options
 }
 
 type Options struct {
@@ -117,7 +157,32 @@ type Options struct {
 }
 
 type AddressOwners struct {
-	User                string
+	User string
+}
+
+type StorageDrivers struct {
+	S3    string
+	Local string
+}
+
+type Locales struct {
+	Persian string
+	English string
+}
+
+// GetTranslationLocales lists the locales news can be translated into. Persian
+// is the language news is written in and is served when no translation exists.
+func (l *Locales) GetTranslationLocales() []string {
+	return []string{l.English}
+}
+
+type ImageRenditions struct {
+	Original  string
+	WebP      string
+	Thumbnail int
+	Small     int
+	Medium    int
+	Large     int
 }
 
 type Queues struct {
@@ -145,25 +210,45 @@ func NewConstants() *Constants {
 			Fatal: "fatal",
 		},
 		Field: ErrorField{
-			User:                "user",
-			Phone:               "phone",
-			Email:               "email",
-			Password:            "password",
-			OTP:                 "otp",
-			NationalID:          "nationalID",
-			RegistrationNumber:  "registrationNumber",
-			IBAN:                "iban",
-			Address:             "address",
-			Name:                "name",
-			Province:            "province",
-			City:                "city",
-			Page:                "page",
-			Role:                "role",
-			Permission:          "permission",
-			News:                "news",
-			Media:               "media",
-			Post:                "post",
-			Like:                "like",
+			User:               "user",
+			Phone:              "phone",
+			Email:              "email",
+			Password:           "password",
+			OTP:                "otp",
+			NationalID:         "nationalID",
+			RegistrationNumber: "registrationNumber",
+			IBAN:               "iban",
+			Address:            "address",
+			Name:               "name",
+			Province:           "province",
+			City:               "city",
+			Page:               "page",
+			Role:               "role",
+			Permission:         "permission",
+			News:               "news",
+			Media:              "media",
+			Post:               "post",
+			Like:               "like",
+			Referral:           "referral",
+			ReferralCode:       "referralCode",
+			GiftCard:           "giftCard",
+			Amount:             "amount",
+			Ingredient:         "ingredient",
+			INCIName:           "inciName",
+			SkinType:           "skinType",
+			SkinConcern:        "skinConcern",
+			File:               "file",
+			Upload:             "upload",
+			PublishAt:          "publishAt",
+			UnpublishAt:        "unpublishAt",
+			NewsCategory:       "newsCategory",
+			NewsTag:            "newsTag",
+			Comment:            "comment",
+			Revision:           "revision",
+			NewsPreview:        "newsPreview",
+			ExpiresInHours:     "expiresInHours",
+			Locale:             "locale",
+			NewsTranslation:    "newsTranslation",
 		},
 		Tag: ErrorTag{
 			AlreadyRegistered:      "alreadyRegistered",
@@ -196,9 +281,21 @@ func NewConstants() *Constants {
 			AlreadyRejected:        "alreadyRejected",
 			AlreadyAccepted:        "alreadyAccepted",
 			AlreadyDraft:           "alreadyDraft",
+			ExpiredItem:            "expiredItem",
+			InsufficientBalance:    "insufficientBalance",
+			UnsupportedFileType:    "unsupportedFileType",
+			FileTooLarge:           "fileTooLarge",
+			InvalidDimensions:      "invalidDimensions",
+			MalwareDetected:        "malwareDetected",
+			FutureTime:             "futureTime",
+			AfterPublishTime:       "afterPublishTime",
+			InvalidReply:           "invalidReply",
+			AlreadyFlagged:         "alreadyFlagged",
+			ExceedsMaximum:         "exceedsMaximum",
 		},
 		SMSTemplates: SMSTemplates{
-			OTP: "sendOTPTemplate",
+			OTP:      "sendOTPTemplate",
+			GiftCard: "sendGiftCardTemplate",
 		},
 		JWTKeysPath: JWTKeysPath{
 			PublicKey:  "./internal/infrastructure/jwt/publicKey.pem",
@@ -218,9 +315,29 @@ func NewConstants() *Constants {
 				Name: "http_request_duration_seconds",
 				Help: "HTTP request duration in seconds",
 			},
+			BusinessEventsTotal: Options{
+				Name: "business_events_total",
+				Help: "Total number of business events by domain and event",
+			},
 		},
 		AddressOwners: AddressOwners{
-			User:                "users",
+			User: "users",
+		},
+		StorageDrivers: StorageDrivers{
+			S3:    "s3",
+			Local: "local",
+		},
+		Locales: Locales{
+			Persian: "fa_IR",
+			English: "en_US",
+		},
+		ImageRenditions: ImageRenditions{
+			Original:  "original",
+			WebP:      "webp",
+			Thumbnail: 150,
+			Small:     320,
+			Medium:    640,
+			Large:     1280,
 		},
 	}
 }
@@ -229,14 +346,67 @@ func (r *RedisKey) GenerateOTPKey(value string) string {
 	return fmt.Sprintf("otp:%s", value)
 }
 
-func (path *BucketPath) GetUserProfilePath(userID uint, pictureFileName string) string {
-	return fmt.Sprintf("user/%d/profile/%s", userID, pictureFileName)
+func (r *RedisKey) GenerateGiftCardLookupKey(userID uint) string {
+	return fmt.Sprintf("giftcard:lookup:%d", userID)
+}
+
+func (r *RedisKey) GenerateCommentRateLimitKey(userID uint) string {
+	return fmt.Sprintf("comment:rate:%d", userID)
+}
+
+func (r *RedisKey) GenerateFeedKey(name string) string {
+	return fmt.Sprintf("feed:%s", name)
+}
+
+func (r *RedisKey) GenerateNewsViewKey(newsID uint, visitor string) string {
+	return fmt.Sprintf("news:view:%d:%s", newsID, visitor)
 }
 
-func (path *BucketPath) GetNewsMediaPath(newsID uint, MediaFileName string) string {
-	return fmt.Sprintf("news/%d/media/%s", newsID, MediaFileName)
+func (r *RedisKey) GenerateNewsViewBufferKey() string {
+	return "news:views:pending"
 }
 
-func (path *BucketPath) GetNewsCoverImagePath(newsID uint, mediaFileName string) string {
-	return fmt.Sprintf("news/%d/cover-image/%s", newsID, mediaFileName)
-}
\ No newline at end of file
+func (r *RedisKey) GenerateLockKey(name string) string {
+	return fmt.Sprintf("lock:%s", name)
+}
+
+// GetWebPName is the name a rendition's WebP copy is stored and served under,
+// such as "thumbnail_webp".
+func (renditions *ImageRenditions) GetWebPName(rendition string) string {
+	return rendition + "_" + renditions.WebP
+}
+
+func (renditions *ImageRenditions) GetWidths() map[string]int {
+	return map[string]int{
+		"thumbnail": renditions.Thumbnail,
+		"small":     renditions.Small,
+		"medium":    renditions.Medium,
+		"large":     renditions.Large,
+	}
+}
+
+func (path *BucketPath) GetUserProfilePath(userID uint, objectName string) string {
+	return fmt.Sprintf("user/%d/profile/%s", userID, objectName)
+}
+
+func (path *BucketPath) GetNewsMediaPath(newsID uint, objectName string) string {
+	return fmt.Sprintf("news/%d/media/%s", newsID, objectName)
+}
+
+func (path *BucketPath) GetNewsCoverImagePath(newsID uint, objectName string) string {
+	return fmt.Sprintf("news/%d/cover-image/%s", newsID, objectName)
+}
+
+func (path *BucketPath) GetNewsOGImagePath(newsID uint, objectName string) string {
+	return fmt.Sprintf("news/%d/og-image/%s", newsID, objectName)
+}
+
+func (path *BucketPath) GetImageRenditionPath(originalKey, rendition string) string {
+	extension := filepath.Ext(originalKey)
+	return fmt.Sprintf("%s_%s%s", strings.TrimSuffix(originalKey, extension), rendition, extension)
+}
+
+func (path *BucketPath) GetWebPRenditionPath(originalKey, rendition string) string {
+	extension := filepath.Ext(originalKey)
+	return fmt.Sprintf("%s_%s.webp", strings.TrimSuffix(originalKey, extension), rendition)
+}
@@ -20,6 +20,12 @@ type Env struct {
 	Pagination         Pagination
 	EmailSenderAccount EmailAccount
 	SuperAdmin         AdminCredentials
+	Referral           Referral
+	GiftCard           GiftCard
+	ImageProcessing    ImageProcessing
+	Upload             Upload
+	News               News
+	Feed               Feed
 }
 
 type Server struct {
@@ -54,11 +60,15 @@ type Redis struct {
 }
 
 type S3 struct {
-	Buckets   BucketName
-	Region    string
-	AccessKey string
-	SecretKey string
-	Endpoint  string
+	Driver     string
+	Buckets    BucketName
+	Region     string
+	AccessKey  string
+	SecretKey  string
+	Endpoint   string
+	LocalPath  string
+	PublicURL  string
+	SigningKey string
 }
 
 type BucketName struct {
@@ -100,6 +110,67 @@ type AdminCredentials struct {
 	NationalCode string
 }
 
+type Referral struct {
+	CodeLength     int
+	ReferrerReward int
+	RefereeReward  int
+	MaxPerIP       int
+	IPWindowHours  int
+}
+
+type GiftCard struct {
+	CodeLength          int
+	LookupLimit         int
+	LookupWindowMinutes int
+}
+
+type ImageProcessing struct {
+	Workers               int
+	QueueSize             int
+	EnqueueTimeoutSeconds int
+	MaxDimension          int
+	JPEGQuality           int
+	WebPQuality           int
+}
+
+type News struct {
+	SchedulerIntervalSeconds int
+	RelatedNewsLimit         int
+	TagCloudLimit            int
+	CommentLimit             int
+	CommentWindowMinutes     int
+	TrustedCommenterApproved int
+	CommentFlagThreshold     int
+	ExcerptLength            int
+	ViewDedupMinutes         int
+	ViewFlushSeconds         int
+	MostReadDays             int
+	MostReadLimit            int
+	AnalyticsDays            int
+	PreviewSigningKey        string
+	PreviewTokenHours        int
+	PreviewTokenMaxHours     int
+}
+
+type Feed struct {
+	SiteURL         string
+	Title           string
+	Description     string
+	Language        string
+	ItemLimit       int
+	CacheTTLMinutes int
+}
+
+type Upload struct {
+	ImageMaxSizeMB            int
+	MediaMaxSizeMB            int
+	DocumentMaxSizeMB         int
+	MinImageDimension         int
+	MaxImageDimension         int
+	DirectUploadExpiryMinutes int
+	CleanupIntervalMinutes    int
+}
+
 func NewEnvironments() *Env {
 	// godotenv.Load("../../.env")
 	godotenv.Load(".env")
@@ -138,10 +209,14 @@ func NewEnvironments() *Env {
 				LogoPic:                os.Getenv("LOGO_PIC_BUCKET_NAME"),
 				NewsMedia:              os.Getenv("NEWS_MEDIA_BUCKET_NAME"),
 			},
-			Region:    os.Getenv("BUCKET_REGION"),
-			AccessKey: os.Getenv("BUCKET_ACCESS_key"),
-			SecretKey: os.Getenv("BUCKET_SECRET_key"),
-			Endpoint:  os.Getenv("BUCKET_ENDPOINT"),
+			Driver:     getEnvString("STORAGE_DRIVER", "s3"),
+			Region:     os.Getenv("BUCKET_REGION"),
+			AccessKey:  os.Getenv("BUCKET_ACCESS_key"),
+			SecretKey:  os.Getenv("BUCKET_SECRET_key"),
+			Endpoint:   os.Getenv("BUCKET_ENDPOINT"),
+			LocalPath:  getEnvString("STORAGE_LOCAL_PATH", "./storage"),
+			PublicURL:  os.Getenv("STORAGE_PUBLIC_URL"),
+			SigningKey: os.Getenv("STORAGE_SIGNING_KEY"),
 		},
 		OTP: OTP{
 			Length:       getEnvInt("OTP_LENGTH", 6),
@@ -169,6 +244,61 @@ func NewEnvironments() *Env {
 			Email:        os.Getenv("SUPER_ADMIN_EMAIL"),
 			NationalCode: os.Getenv("SUPER_ADMIN_NATIONAL_CODE"),
 		},
+		Referral: Referral{
+			CodeLength:     getEnvInt("REFERRAL_CODE_LENGTH", 8),
+			ReferrerReward: getEnvInt("REFERRAL_REFERRER_REWARD", 100),
+			RefereeReward:  getEnvInt("REFERRAL_REFEREE_REWARD", 50),
+			MaxPerIP:       getEnvInt("REFERRAL_MAX_PER_IP", 3),
+			IPWindowHours:  getEnvInt("REFERRAL_IP_WINDOW_HOURS", 24),
+		},
+		GiftCard: GiftCard{
+			CodeLength:          getEnvInt("GIFT_CARD_CODE_LENGTH", 16),
+			LookupLimit:         getEnvInt("GIFT_CARD_LOOKUP_LIMIT", 5),
+			LookupWindowMinutes: getEnvInt("GIFT_CARD_LOOKUP_WINDOW_MINUTES", 15),
+		},
+		ImageProcessing: ImageProcessing{
+			Workers:               getEnvInt("IMAGE_PROCESSING_WORKERS", 2),
+			QueueSize:             getEnvInt("IMAGE_PROCESSING_QUEUE_SIZE", 100),
+			EnqueueTimeoutSeconds: getEnvInt("IMAGE_PROCESSING_ENQUEUE_TIMEOUT_SECONDS", 5),
+			MaxDimension:          getEnvInt("IMAGE_MAX_DIMENSION", 2048),
+			JPEGQuality:           getEnvInt("IMAGE_JPEG_QUALITY", 85),
+			WebPQuality:           getEnvInt("IMAGE_WEBP_QUALITY", 80),
+		},
+		Upload: Upload{
+			ImageMaxSizeMB:            getEnvInt("UPLOAD_IMAGE_MAX_SIZE_MB", 5),
+			MediaMaxSizeMB:            getEnvInt("UPLOAD_MEDIA_MAX_SIZE_MB", 50),
+			DocumentMaxSizeMB:         getEnvInt("UPLOAD_DOCUMENT_MAX_SIZE_MB", 10),
+			MinImageDimension:         getEnvInt("UPLOAD_MIN_IMAGE_DIMENSION", 32),
+			MaxImageDimension:         getEnvInt("UPLOAD_MAX_IMAGE_DIMENSION", 8000),
+			DirectUploadExpiryMinutes: getEnvInt("UPLOAD_DIRECT_EXPIRY_MINUTES", 15),
+			CleanupIntervalMinutes:    getEnvInt("UPLOAD_CLEANUP_INTERVAL_MINUTES", 60),
+		},
+		News: News{
+			SchedulerIntervalSeconds: getEnvInt("NEWS_SCHEDULER_INTERVAL_SECONDS", 60),
+			RelatedNewsLimit:         getEnvInt("NEWS_RELATED_LIMIT", 5),
+			TagCloudLimit:            getEnvInt("NEWS_TAG_CLOUD_LIMIT", 30),
+			CommentLimit:             getEnvInt("NEWS_COMMENT_LIMIT", 5),
+			CommentWindowMinutes:     getEnvInt("NEWS_COMMENT_WINDOW_MINUTES", 10),
+			TrustedCommenterApproved: getEnvInt("NEWS_TRUSTED_COMMENTER_APPROVED", 3),
+			CommentFlagThreshold:     getEnvInt("NEWS_COMMENT_FLAG_THRESHOLD", 3),
+			ExcerptLength:            getEnvInt("NEWS_EXCERPT_LENGTH", 200),
+			ViewDedupMinutes:         getEnvInt("NEWS_VIEW_DEDUP_MINUTES", 30),
+			ViewFlushSeconds:         getEnvInt("NEWS_VIEW_FLUSH_SECONDS", 60),
+			MostReadDays:             getEnvInt("NEWS_MOST_READ_DAYS", 7),
+			MostReadLimit:            getEnvInt("NEWS_MOST_READ_LIMIT", 10),
+			AnalyticsDays:            getEnvInt("NEWS_ANALYTICS_DAYS", 30),
+			PreviewSigningKey:        os.Getenv("NEWS_PREVIEW_SIGNING_KEY"),
+			PreviewTokenHours:        getEnvInt("NEWS_PREVIEW_TOKEN_HOURS", 72),
+			PreviewTokenMaxHours:     getEnvInt("NEWS_PREVIEW_TOKEN_MAX_HOURS", 168),
+		},
+		Feed: Feed{
+			SiteURL:         os.Getenv("FEED_SITE_URL"),
+			Title:           getEnvString("FEED_TITLE", "Cosmetics Shiraz"),
+			Description:     getEnvString("FEED_DESCRIPTION", "Cosmetics Shiraz news"),
+			Language:        getEnvString("FEED_LANGUAGE", "fa"),
+			ItemLimit:       getEnvInt("FEED_ITEM_LIMIT", 50),
+			CacheTTLMinutes: getEnvInt("FEED_CACHE_TTL_MINUTES", 60),
+		},
 	}
 }
 
@@ -181,6 +311,13 @@ func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
 	return defaultVal
 }
 
+func getEnvString(key string, defaultVal string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return defaultVal
+}
+
 func getEnvInt(key string, defaultVal int) int {
 	if val := os.Getenv(key); val != "" {
 		if parsed, err := strconv.Atoi(val); err == nil {
@@ -30,12 +30,32 @@ func main() {
 		&entity.User{},
 		&entity.Media{},
 		&entity.News{},
+		&entity.NewsSlugRedirect{},
+		&entity.NewsCategory{},
+		&entity.NewsTag{},
+		&entity.NewsComment{},
+		&entity.NewsCommentFlag{},
+		&entity.NewsRevision{},
+		&entity.NewsDailyView{},
+		&entity.NewsPreviewToken{},
+		&entity.NewsTranslation{},
 		&entity.Like{},
+		&entity.Referral{},
+		&entity.GiftCard{},
+		&entity.GiftCardTransaction{},
+		&entity.Ingredient{},
+		&entity.UserSkinConcern{},
+		&entity.ImageRendition{},
+		&entity.PendingUpload{},
 	)
 
 	app.Seeds.AddressSeeder.SeedProvincesAndCities()
 	app.Seeds.RoleSeeder.SeedRoles()
 
+	app.Jobs.UploadCleanupJob.Start()
+	app.Jobs.NewsSchedulerJob.Start()
+	app.Jobs.NewsViewFlushJob.Start()
+
 	routes.Run(ginEngine, app)
 
 	ginEngine.Run(fmt.Sprintf(":%v", config.Env.Server.Port))
@@ -0,0 +1,44 @@
+package giftcarddto
+
+import "time"
+
+type IssueGiftCardRequest struct {
+	IssuerID       uint
+	Value          uint
+	ExpiresAt      time.Time
+	RecipientName  string
+	RecipientPhone string
+	RecipientEmail string
+	TemplateFile   string
+	EmailSubject   string
+}
+
+type ResendGiftCardRequest struct {
+	GiftCardID   uint
+	TemplateFile string
+	EmailSubject string
+}
+
+type GetGiftCardBalanceRequest struct {
+	UserID uint
+	Code   string
+}
+
+type RedeemGiftCardRequest struct {
+	Code      string
+	Amount    uint
+	Reference string
+	ActorID   *uint
+}
+
+type GetGiftCardsRequest struct {
+	Status uint
+	Offset int
+	Limit  int
+}
+
+type GetGiftCardTransactionsRequest struct {
+	GiftCardID uint
+	Offset     int
+	Limit      int
+}
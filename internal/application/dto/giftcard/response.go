@@ -0,0 +1,47 @@
+package giftcarddto
+
+import "time"
+
+type IssuedGiftCardResponse struct {
+	ID        uint      `json:"id"`
+	Code      string    `json:"code"`
+	Value     uint      `json:"value"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Delivered bool      `json:"delivered"`
+}
+
+type GiftCardBalanceResponse struct {
+	Value     uint      `json:"value"`
+	Balance   uint      `json:"balance"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Status    string    `json:"status"`
+}
+
+type GiftCardResponse struct {
+	ID             uint       `json:"id"`
+	Code           string     `json:"code"`
+	Value          uint       `json:"value"`
+	Balance        uint       `json:"balance"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	Status         string     `json:"status"`
+	RecipientName  string     `json:"recipientName"`
+	RecipientPhone string     `json:"recipientPhone"`
+	RecipientEmail string     `json:"recipientEmail"`
+	DeliveredAt    *time.Time `json:"deliveredAt"`
+	DeliveryError  string     `json:"deliveryError"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+type GiftCardTransactionResponse struct {
+	ID           uint      `json:"id"`
+	Type         string    `json:"type"`
+	Amount       uint      `json:"amount"`
+	BalanceAfter uint      `json:"balanceAfter"`
+	Reference    string    `json:"reference"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type GiftCardStatusesResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,36 @@
+package ingredientdto
+
+type GetIngredientsRequest struct {
+	Search string
+	Offset int
+	Limit  int
+}
+
+type CreateIngredientRequest struct {
+	INCIName          string
+	PersianName       string
+	IsParaben         bool
+	IsFragrance       bool
+	ComedogenicRating uint
+}
+
+type UpdateIngredientRequest struct {
+	IngredientID      uint
+	INCIName          *string
+	PersianName       *string
+	IsParaben         *bool
+	IsFragrance       *bool
+	ComedogenicRating *uint
+}
+
+type UpdateSkinProfileRequest struct {
+	UserID     uint
+	SkinType   *uint
+	AllergyIDs []uint
+	Concerns   []uint
+}
+
+type CheckIngredientsRequest struct {
+	UserID        uint
+	IngredientIDs []uint
+}
@@ -0,0 +1,36 @@
+package ingredientdto
+
+type IngredientResponse struct {
+	ID                uint   `json:"id"`
+	INCIName          string `json:"inciName"`
+	PersianName       string `json:"persianName"`
+	IsParaben         bool   `json:"isParaben"`
+	IsFragrance       bool   `json:"isFragrance"`
+	ComedogenicRating uint   `json:"comedogenicRating"`
+}
+
+type SkinTypesResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type SkinConcernsResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type SkinProfileResponse struct {
+	SkinType  *SkinTypesResponse     `json:"skinType"`
+	Allergies []IngredientResponse   `json:"allergies"`
+	Concerns  []SkinConcernsResponse `json:"concerns"`
+}
+
+type IngredientWarningResponse struct {
+	Ingredient IngredientResponse `json:"ingredient"`
+	Reason     string             `json:"reason"`
+}
+
+type SuitabilityResponse struct {
+	Score    int                         `json:"score"`
+	Warnings []IngredientWarningResponse `json:"warnings"`
+}
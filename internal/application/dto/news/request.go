@@ -2,6 +2,7 @@ package newsdto
 
 import (
 	"mime/multipart"
+	"time"
 
 	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
 )
@@ -16,13 +17,16 @@ type CreateNewsRequest struct {
 }
 
 type EditNewsRequest struct {
-	NewsID      uint
-	AuthorID    uint
-	Title       *string
-	Content     *string
-	Description *string
-	Status      uint
-	CoverImage  *multipart.FileHeader
+	NewsID          uint
+	AuthorID        uint
+	Title           *string
+	Content         *string
+	Description     *string
+	Status          uint
+	CoverImage      *multipart.FileHeader
+	MetaTitle       *string
+	MetaDescription *string
+	OGImage         *multipart.FileHeader
 }
 
 type EditNewsStatusRequest struct {
@@ -31,6 +35,13 @@ type EditNewsStatusRequest struct {
 	Status   uint
 }
 
+type ScheduleNewsRequest struct {
+	NewsID      uint
+	AuthorID    uint
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+}
+
 type DeleteNewsRequest struct {
 	NewsIDs  []uint
 	AuthorID uint
@@ -43,8 +54,27 @@ type GetAdminNewsListRequest struct {
 }
 
 type GetPublicNewsListRequest struct {
-	Offset int
-	Limit  int
+	CategorySlug string
+	TagSlug      string
+	Locale       string
+	Offset       int
+	Limit        int
+}
+
+type CreateNewsCategoryRequest struct {
+	Name string
+}
+
+type UpdateNewsCategoryRequest struct {
+	CategoryID uint
+	Name       *string
+}
+
+type UpdateNewsTaxonomyRequest struct {
+	NewsID      uint
+	AuthorID    uint
+	CategoryIDs []uint
+	Tags        []string
 }
 
 // type GetNewsRequest struct {
@@ -59,8 +89,125 @@ type AddNewsMediaRequest struct {
 }
 
 type AccessMediaRequest struct {
+	NewsID       uint
+	AuthorID     uint
+	MediaID      uint
+	UserType     enum.UserType
+	PreviewToken string
+}
+
+type CreateNewsUploadRequest struct {
+	NewsID      uint
+	AuthorID    uint
+	Purpose     uint
+	ContentType string
+	Size        int64
+}
+
+type ConfirmNewsUploadRequest struct {
+	NewsID   uint
+	AuthorID uint
+	UploadID uint
+}
+
+type GetNewsRevisionsRequest struct {
+	NewsID uint
+	Offset int
+	Limit  int
+}
+
+type CompareNewsRevisionsRequest struct {
+	NewsID         uint
+	FromRevisionID uint
+	ToRevisionID   uint
+}
+
+type RestoreNewsRevisionRequest struct {
+	NewsID     uint
+	RevisionID uint
+	AuthorID   uint
+}
+
+type CreateNewsCommentRequest struct {
+	NewsID   uint
+	UserID   uint
+	ParentID *uint
+	Content  string
+}
+
+type FlagNewsCommentRequest struct {
+	CommentID uint
+	UserID    uint
+	Reason    string
+}
+
+type GetNewsCommentsRequest struct {
+	NewsID uint
+	Offset int
+	Limit  int
+}
+
+type GetCommentQueueRequest struct {
+	Status uint
+	Offset int
+	Limit  int
+}
+
+type ReviewNewsCommentRequest struct {
+	CommentID  uint
+	ReviewerID uint
+	Action     uint
+}
+
+type RecordNewsViewRequest struct {
+	NewsID    uint
+	VisitorID string
+}
+
+type GetNewsAnalyticsRequest struct {
+	NewsID uint
+	Days   int
+}
+
+type GetPublicNewsRequest struct {
+	NewsID       uint
+	PreviewToken string
+	Locale       string
+}
+
+type GetPublicNewsBySlugRequest struct {
+	Slug   string
+	Locale string
+}
+
+type GetRelatedNewsRequest struct {
+	NewsID uint
+	Locale string
+}
+
+type SaveNewsTranslationRequest struct {
+	NewsID      uint
+	AuthorID    uint
+	Locale      string
+	Title       string
+	Description string
+	Content     string
+}
+
+type DeleteNewsTranslationRequest struct {
 	NewsID   uint
 	AuthorID uint
-	MediaID  uint
-	UserType enum.UserType
+	Locale   string
+}
+
+type CreateNewsPreviewRequest struct {
+	NewsID         uint
+	AuthorID       uint
+	ExpiresInHours int
+}
+
+type RevokeNewsPreviewRequest struct {
+	NewsID    uint
+	PreviewID uint
+	AuthorID  uint
 }
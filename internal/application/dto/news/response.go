@@ -1,28 +1,160 @@
 package newsdto
 
 import (
+	"time"
+
 	userdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/user"
 )
 
+type NewsSEOResponse struct {
+	MetaTitle       string            `json:"metaTitle"`
+	MetaDescription string            `json:"metaDescription"`
+	OGImage         map[string]string `json:"ogImage"`
+}
+
 type AdminNewsResponse struct {
 	ID          uint                       `json:"id"`
 	Title       string                     `json:"title"`
+	Slug        string                     `json:"slug"`
 	Content     string                     `json:"content"`
 	Description string                     `json:"description"`
 	Status      string                     `json:"status"`
-	CoverImage  string                     `json:"coverImage"`
+	CoverImage  map[string]string          `json:"coverImage"`
 	Author      userdto.CredentialResponse `json:"author"`
+	PublishAt   *time.Time                 `json:"publishAt,omitempty"`
+	UnpublishAt *time.Time                 `json:"unpublishAt,omitempty"`
+	SEO         NewsSEOResponse            `json:"seo"`
+	Categories  []NewsCategoryResponse     `json:"categories"`
+	Tags        []NewsTagResponse          `json:"tags"`
 }
 
 type PublicNewsResponse struct {
-	ID          uint   `json:"id"`
-	Title       string `json:"title"`
-	Content     string `json:"content"`
-	Description string `json:"description"`
-	CoverImage  string `json:"coverImage"`
+	ID           uint                   `json:"id"`
+	Title        string                 `json:"title"`
+	Slug         string                 `json:"slug"`
+	Content      string                 `json:"content"`
+	Description  string                 `json:"description"`
+	CoverImage   map[string]string      `json:"coverImage"`
+	SEO          NewsSEOResponse        `json:"seo"`
+	Categories   []NewsCategoryResponse `json:"categories"`
+	Tags         []NewsTagResponse      `json:"tags"`
+	CommentCount int64                  `json:"commentCount"`
+	Locale       string                 `json:"locale"`
+}
+
+type NewsCategoryResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type NewsTagResponse struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type NewsTagCloudResponse struct {
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+	NewsCount int64  `json:"newsCount"`
 }
 
 type NewsStatusesResponse struct {
 	ID   uint   `json:"id"`
 	Name string `json:"name"`
 }
+
+type NewsUploadResponse struct {
+	ID          uint      `json:"id"`
+	URL         string    `json:"url"`
+	ContentType string    `json:"contentType"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+type ConfirmNewsUploadResponse struct {
+	MediaID    uint              `json:"mediaID,omitempty"`
+	CoverImage map[string]string `json:"coverImage,omitempty"`
+}
+
+type NewsRevisionResponse struct {
+	ID           uint                       `json:"id"`
+	Number       uint                       `json:"number"`
+	Editor       userdto.CredentialResponse `json:"editor"`
+	Title        string                     `json:"title"`
+	RestoredFrom *uint                      `json:"restoredFrom,omitempty"`
+	CreatedAt    time.Time                  `json:"createdAt"`
+}
+
+type DiffLineResponse struct {
+	Operation string `json:"operation"`
+	Text      string `json:"text"`
+}
+
+type NewsRevisionDiffResponse struct {
+	From        NewsRevisionResponse `json:"from"`
+	To          NewsRevisionResponse `json:"to"`
+	Title       []DiffLineResponse   `json:"title"`
+	Description []DiffLineResponse   `json:"description"`
+	Content     []DiffLineResponse   `json:"content"`
+}
+
+type NewsCommentResponse struct {
+	ID        uint                  `json:"id"`
+	Author    string                `json:"author"`
+	Content   string                `json:"content"`
+	CreatedAt time.Time             `json:"createdAt"`
+	Replies   []NewsCommentResponse `json:"replies,omitempty"`
+}
+
+type AdminNewsCommentResponse struct {
+	ID        uint                       `json:"id"`
+	NewsID    uint                       `json:"newsID"`
+	ParentID  *uint                      `json:"parentID,omitempty"`
+	Author    userdto.CredentialResponse `json:"author"`
+	Content   string                     `json:"content"`
+	Status    string                     `json:"status"`
+	FlagCount uint                       `json:"flagCount"`
+	CreatedAt time.Time                  `json:"createdAt"`
+}
+
+type CreateNewsCommentResponse struct {
+	ID     uint   `json:"id"`
+	Status string `json:"status"`
+}
+
+type CommentStatusesResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type NewsDailyStatsResponse struct {
+	Date     string `json:"date"`
+	Views    int64  `json:"views"`
+	Likes    int64  `json:"likes"`
+	Comments int64  `json:"comments"`
+}
+
+type NewsAnalyticsResponse struct {
+	NewsID   uint                     `json:"newsID"`
+	Views    int64                    `json:"views"`
+	Likes    int64                    `json:"likes"`
+	Comments int64                    `json:"comments"`
+	Daily    []NewsDailyStatsResponse `json:"daily"`
+}
+
+type NewsPreviewResponse struct {
+	ID        uint                       `json:"id"`
+	Token     string                     `json:"token"`
+	CreatedBy userdto.CredentialResponse `json:"createdBy"`
+	ExpiresAt time.Time                  `json:"expiresAt"`
+	CreatedAt time.Time                  `json:"createdAt"`
+}
+
+type NewsTranslationResponse struct {
+	Locale      string                     `json:"locale"`
+	Title       string                     `json:"title"`
+	Description string                     `json:"description"`
+	Content     string                     `json:"content"`
+	Editor      userdto.CredentialResponse `json:"editor"`
+	UpdatedAt   time.Time                  `json:"updatedAt"`
+}
@@ -0,0 +1,12 @@
+package referraldto
+
+type ReviewReferralRequest struct {
+	ReferralID uint
+	Status     uint
+}
+
+type GetReferralReportRequest struct {
+	Status uint
+	Offset int
+	Limit  int
+}
@@ -0,0 +1,38 @@
+package referraldto
+
+import (
+	"time"
+
+	userdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/user"
+)
+
+type UserReferralResponse struct {
+	Code         string `json:"code"`
+	RewardPoints uint   `json:"rewardPoints"`
+	Invited      int64  `json:"invited"`
+	Converted    int64  `json:"converted"`
+}
+
+type ReferralResponse struct {
+	ID             uint                       `json:"id"`
+	Referrer       userdto.CredentialResponse `json:"referrer"`
+	Referee        userdto.CredentialResponse `json:"referee"`
+	Status         string                     `json:"status"`
+	ReferrerReward uint                       `json:"referrerReward"`
+	RefereeReward  uint                       `json:"refereeReward"`
+	CreatedAt      time.Time                  `json:"createdAt"`
+	ConvertedAt    *time.Time                 `json:"convertedAt"`
+}
+
+type ReferralSummaryResponse struct {
+	Pending       int64 `json:"pending"`
+	Converted     int64 `json:"converted"`
+	Rejected      int64 `json:"rejected"`
+	UnderReview   int64 `json:"underReview"`
+	AwardedPoints uint  `json:"awardedPoints"`
+}
+
+type ReferralStatusesResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,17 @@
+package storagedto
+
+type GetSignedObjectRequest struct {
+	Bucket    string
+	Key       string
+	Expires   int64
+	Signature string
+}
+
+type PutSignedObjectRequest struct {
+	Bucket      string
+	Key         string
+	Expires     int64
+	Signature   string
+	ContentType string
+	Body        []byte
+}
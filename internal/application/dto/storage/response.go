@@ -0,0 +1,6 @@
+package storagedto
+
+type ObjectResponse struct {
+	Data        []byte
+	ContentType string
+}
@@ -3,10 +3,13 @@ package userdto
 import "mime/multipart"
 
 type BasicRegisterRequest struct {
-	FirstName string
-	LastName  string
-	Phone     string
-	Password  string
+	FirstName    string
+	LastName     string
+	Phone        string
+	Password     string
+	ReferralCode string
+	DeviceID     string
+	ClientIP     string
 }
 
 type VerifyPhoneRequest struct {
@@ -81,3 +84,9 @@ type GetPermissionRolesRequest struct {
 	Offset       int
 	Limit        int
 }
+
+type UpdateNotificationPreferencesRequest struct {
+	UserID             uint
+	CartReminderOptOut *bool
+	StockAlertOptOut   *bool
+}
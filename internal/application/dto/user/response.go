@@ -14,14 +14,14 @@ type UserInfoResponse struct {
 }
 
 type CredentialResponse struct {
-	ID         uint   `json:"id"`
-	FirstName  string `json:"firstName"`
-	LastName   string `json:"lastName"`
-	Phone      string `json:"phone"`
-	Email      string `json:"email"`
-	NationalID string `json:"nationalID"`
-	ProfilePic string `json:"profilePic"`
-	Status     string `json:"status"`
+	ID         uint              `json:"id"`
+	FirstName  string            `json:"firstName"`
+	LastName   string            `json:"lastName"`
+	Phone      string            `json:"phone"`
+	Email      string            `json:"email"`
+	NationalID string            `json:"nationalID"`
+	ProfilePic map[string]string `json:"profilePic"`
+	Status     string            `json:"status"`
 }
 
 type UserResponse struct {
@@ -40,3 +40,8 @@ type PermissionResponse struct {
 	Description string `json:"description"`
 	Category    string `json:"category"`
 }
+
+type NotificationPreferencesResponse struct {
+	CartReminderOptOut bool `json:"cartReminderOptOut"`
+	StockAlertOptOut   bool `json:"stockAlertOptOut"`
+}
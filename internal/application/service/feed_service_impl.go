@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"encoding/xml"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	feeddto "github.com/CosmeticsShiraz/Backend/internal/application/dto/feed"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/redis"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	postgresImpl "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
+)
+
+const (
+	newsRSSFeed  = "news.rss"
+	newsAtomFeed = "news.atom"
+	sitemapFeed  = "sitemap.xml"
+)
+
+type FeedService struct {
+	constants           *bootstrap.Constants
+	feedConfig          *bootstrap.Feed
+	newsRepository      postgres.NewsRepository
+	feedCacheRepository redis.FeedCacheRepository
+	db                  database.Database
+}
+
+func NewFeedService(
+	constants *bootstrap.Constants,
+	feedConfig *bootstrap.Feed,
+	newsRepository postgres.NewsRepository,
+	feedCacheRepository redis.FeedCacheRepository,
+	db database.Database,
+) *FeedService {
+	return &FeedService{
+		constants:           constants,
+		feedConfig:          feedConfig,
+		newsRepository:      newsRepository,
+		feedCacheRepository: feedCacheRepository,
+		db:                  db,
+	}
+}
+
+// getCachedDocument serves a feed from Redis, building and storing it on a miss.
+func (feedService *FeedService) getCachedDocument(name string, build func() (any, error)) ([]byte, error) {
+	ctx := context.Background()
+	key := feedService.constants.RedisKey.GenerateFeedKey(name)
+	document, err := feedService.feedCacheRepository.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if document != nil {
+		return document, nil
+	}
+
+	response, err := build()
+	if err != nil {
+		return nil, err
+	}
+	body, err := xml.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	document = append([]byte(xml.Header), body...)
+
+	expiration := time.Duration(feedService.feedConfig.CacheTTLMinutes) * time.Minute
+	if err := feedService.feedCacheRepository.Set(ctx, key, document, expiration); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+func (feedService *FeedService) siteLink(path string) string {
+	return strings.TrimRight(feedService.feedConfig.SiteURL, "/") + path
+}
+
+func (feedService *FeedService) newsLink(news *entity.News) string {
+	reference := news.Slug
+	if reference == "" {
+		reference = strconv.FormatUint(uint64(news.ID), 10)
+	}
+	return feedService.siteLink("/news/" + url.PathEscape(reference))
+}
+
+// publishedAt falls back to the creation time for news published before
+// publication times were recorded.
+func publishedAt(news *entity.News) time.Time {
+	if news.PublishedAt != nil {
+		return *news.PublishedAt
+	}
+	return news.CreatedAt
+}
+
+func (feedService *FeedService) getLatestNews() ([]*entity.News, error) {
+	paginationModifier := postgresImpl.NewPaginationModifier(feedService.feedConfig.ItemLimit, 0)
+	sortingModifier := postgresImpl.NewSortingModifier("COALESCE(published_at, created_at)", true)
+
+	allowedStatuses := []enum.NewsStatus{enum.NewsStatusActive}
+	return feedService.newsRepository.FindNewsByStatus(feedService.db, allowedStatuses, paginationModifier, sortingModifier)
+}
+
+func (feedService *FeedService) getNewsCategoryNames(newsID uint) ([]string, error) {
+	categories, err := feedService.newsRepository.FindNewsCategoriesByNewsID(feedService.db, newsID)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(categories))
+	for i, category := range categories {
+		names[i] = category.Name
+	}
+	return names, nil
+}
+
+func (feedService *FeedService) GetNewsRSS() ([]byte, error) {
+	return feedService.getCachedDocument(newsRSSFeed, func() (any, error) {
+		news, err := feedService.getLatestNews()
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]feeddto.RSSItem, len(news))
+		for i, eachNews := range news {
+			categories, err := feedService.getNewsCategoryNames(eachNews.ID)
+			if err != nil {
+				return nil, err
+			}
+			link := feedService.newsLink(eachNews)
+			items[i] = feeddto.RSSItem{
+				Title:       eachNews.Title,
+				Link:        link,
+				GUID:        feeddto.RSSGUID{Value: link, IsPermaLink: true},
+				Description: eachNews.Description,
+				Categories:  categories,
+				PubDate:     publishedAt(eachNews).Format(time.RFC1123Z),
+			}
+		}
+
+		return feeddto.RSSResponse{
+			Version: "2.0",
+			Channel: feeddto.RSSChannel{
+				Title:         feedService.feedConfig.Title,
+				Link:          feedService.siteLink("/news"),
+				Description:   feedService.feedConfig.Description,
+				Language:      feedService.feedConfig.Language,
+				LastBuildDate: time.Now().Format(time.RFC1123Z),
+				Items:         items,
+			},
+		}, nil
+	})
+}
+
+func (feedService *FeedService) GetNewsAtom() ([]byte, error) {
+	return feedService.getCachedDocument(newsAtomFeed, func() (any, error) {
+		news, err := feedService.getLatestNews()
+		if err != nil {
+			return nil, err
+		}
+
+		updated := time.Time{}
+		entries := make([]feeddto.AtomEntry, len(news))
+		for i, eachNews := range news {
+			categoryNames, err := feedService.getNewsCategoryNames(eachNews.ID)
+			if err != nil {
+				return nil, err
+			}
+			categories := make([]feeddto.AtomCategory, len(categoryNames))
+			for j, name := range categoryNames {
+				categories[j] = feeddto.AtomCategory{Term: name}
+			}
+			if eachNews.UpdatedAt.After(updated) {
+				updated = eachNews.UpdatedAt
+			}
+
+			link := feedService.newsLink(eachNews)
+			entries[i] = feeddto.AtomEntry{
+				ID:         link,
+				Title:      eachNews.Title,
+				Link:       feeddto.AtomLink{Href: link, Rel: "alternate", Type: "text/html"},
+				Summary:    eachNews.Description,
+				Categories: categories,
+				Published:  publishedAt(eachNews).Format(time.RFC3339),
+				Updated:    eachNews.UpdatedAt.Format(time.RFC3339),
+			}
+		}
+		if updated.IsZero() {
+			updated = time.Now()
+		}
+
+		return feeddto.AtomResponse{
+			Lang:     feedService.feedConfig.Language,
+			ID:       feedService.siteLink("/news"),
+			Title:    feedService.feedConfig.Title,
+			Subtitle: feedService.feedConfig.Description,
+			Updated:  updated.Format(time.RFC3339),
+			Author:   feeddto.AtomAuthor{Name: feedService.feedConfig.Title},
+			Links: []feeddto.AtomLink{
+				{Href: feedService.siteLink("/news"), Rel: "alternate", Type: "text/html"},
+			},
+			Entries: entries,
+		}, nil
+	})
+}
+
+// GetSitemap lists every published news and news category. Products and their
+// categories are meant to be appended here as they get public pages.
+func (feedService *FeedService) GetSitemap() ([]byte, error) {
+	return feedService.getCachedDocument(sitemapFeed, func() (any, error) {
+		sortingModifier := postgresImpl.NewSortingModifier("COALESCE(published_at, created_at)", true)
+		allowedStatuses := []enum.NewsStatus{enum.NewsStatusActive}
+		news, err := feedService.newsRepository.FindNewsByStatus(feedService.db, allowedStatuses, sortingModifier)
+		if err != nil {
+			return nil, err
+		}
+		categories, err := feedService.newsRepository.FindNewsCategories(feedService.db)
+		if err != nil {
+			return nil, err
+		}
+
+		urls := make([]feeddto.SitemapURL, 0, len(news)+len(categories)+1)
+		urls = append(urls, feeddto.SitemapURL{
+			Loc:        feedService.siteLink("/news"),
+			ChangeFreq: "daily",
+		})
+		for _, eachNews := range news {
+			urls = append(urls, feeddto.SitemapURL{
+				Loc:        feedService.newsLink(eachNews),
+				LastMod:    eachNews.UpdatedAt.Format(time.RFC3339),
+				ChangeFreq: "weekly",
+			})
+		}
+		for _, category := range categories {
+			urls = append(urls, feeddto.SitemapURL{
+				Loc:        feedService.siteLink("/news/category/" + url.PathEscape(category.Slug)),
+				ChangeFreq: "daily",
+			})
+		}
+
+		return feeddto.SitemapResponse{URLs: urls}, nil
+	})
+}
+
+func (feedService *FeedService) InvalidateFeeds() error {
+	keys := []string{
+		feedService.constants.RedisKey.GenerateFeedKey(newsRSSFeed),
+		feedService.constants.RedisKey.GenerateFeedKey(newsAtomFeed),
+		feedService.constants.RedisKey.GenerateFeedKey(sitemapFeed),
+	}
+	return feedService.feedCacheRepository.Delete(context.Background(), keys...)
+}
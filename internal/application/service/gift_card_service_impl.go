@@ -0,0 +1,435 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	giftcarddto "github.com/CosmeticsShiraz/Backend/internal/application/dto/giftcard"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/communication"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/logger"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/redis"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	postgresImpl "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
+)
+
+type GiftCardService struct {
+	constants                *bootstrap.Constants
+	giftCardConfig           *bootstrap.GiftCard
+	logger                   logger.Logger
+	userService              usecase.UserService
+	smsService               communication.SMSService
+	emailService             communication.EmailService
+	giftCardRepository       postgres.GiftCardRepository
+	rateLimitCacheRepository redis.RateLimitCacheRepository
+	db                       database.Database
+}
+
+func NewGiftCardService(
+	constants *bootstrap.Constants,
+	giftCardConfig *bootstrap.GiftCard,
+	logger logger.Logger,
+	userService usecase.UserService,
+	smsService communication.SMSService,
+	emailService communication.EmailService,
+	giftCardRepository postgres.GiftCardRepository,
+	rateLimitCacheRepository redis.RateLimitCacheRepository,
+	db database.Database,
+) *GiftCardService {
+	return &GiftCardService{
+		constants:                constants,
+		giftCardConfig:           giftCardConfig,
+		logger:                   logger,
+		userService:              userService,
+		smsService:               smsService,
+		emailService:             emailService,
+		giftCardRepository:       giftCardRepository,
+		rateLimitCacheRepository: rateLimitCacheRepository,
+		db:                       db,
+	}
+}
+
+var giftCardCodeTable = []byte("ABCDEFGHJKLMNPQRSTUVWXYZ23456789")
+
+func (giftCardService *GiftCardService) generateGiftCardCode() (string, error) {
+	length := giftCardService.giftCardConfig.CodeLength
+	for attempt := 0; attempt < 5; attempt++ {
+		code := make([]byte, length)
+		if _, err := io.ReadFull(rand.Reader, code); err != nil {
+			return "", err
+		}
+		for i := 0; i < len(code); i++ {
+			code[i] = giftCardCodeTable[int(code[i])%len(giftCardCodeTable)]
+		}
+
+		giftCard, err := giftCardService.giftCardRepository.FindGiftCardByCode(giftCardService.db, string(code))
+		if err != nil {
+			return "", err
+		}
+		if giftCard == nil {
+			return string(code), nil
+		}
+	}
+	return "", fmt.Errorf("unable to generate unique gift card code")
+}
+
+func (giftCardService *GiftCardService) normalizeCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+	return code
+}
+
+func (giftCardService *GiftCardService) formatCode(code string) string {
+	var builder strings.Builder
+	for i, char := range code {
+		if i > 0 && i%4 == 0 {
+			builder.WriteByte('-')
+		}
+		builder.WriteRune(char)
+	}
+	return builder.String()
+}
+
+func (giftCardService *GiftCardService) maskCode(code string) string {
+	if len(code) <= 4 {
+		return code
+	}
+	return giftCardService.formatCode(strings.Repeat("*", len(code)-4) + code[len(code)-4:])
+}
+
+func (giftCardService *GiftCardService) mapToFilterStatuses(enumStatus uint) []enum.GiftCardStatus {
+	statuses := enum.GetAllGiftCardStatuses()
+	for _, status := range statuses {
+		if uint(status) == enumStatus {
+			if status == enum.GiftCardStatusAll {
+				return statuses
+			}
+			return []enum.GiftCardStatus{status}
+		}
+	}
+	return statuses
+}
+
+func (giftCardService *GiftCardService) checkGiftCardUsable(giftCard *entity.GiftCard) error {
+	var conflictErrors exception.ConflictErrors
+	if giftCard.Status != enum.GiftCardStatusActive {
+		conflictErrors.Add(giftCardService.constants.Field.GiftCard, giftCardService.constants.Tag.NotActive)
+		return conflictErrors
+	}
+	if time.Now().After(giftCard.ExpiresAt) {
+		conflictErrors.Add(giftCardService.constants.Field.GiftCard, giftCardService.constants.Tag.ExpiredItem)
+		return conflictErrors
+	}
+	return nil
+}
+
+func (giftCardService *GiftCardService) GetAllGiftCardStatuses() []giftcarddto.GiftCardStatusesResponse {
+	allowedStatuses := []enum.GiftCardStatus{
+		enum.GiftCardStatusActive,
+		enum.GiftCardStatusDisabled,
+	}
+
+	statuses := make([]giftcarddto.GiftCardStatusesResponse, len(allowedStatuses))
+	for i, status := range allowedStatuses {
+		statuses[i] = giftcarddto.GiftCardStatusesResponse{
+			ID:   uint(status),
+			Name: status.String(),
+		}
+	}
+	return statuses
+}
+
+func (giftCardService *GiftCardService) deliverGiftCard(giftCard *entity.GiftCard, templateFile, emailSubject string) error {
+	code := giftCardService.formatCode(giftCard.Code)
+	if giftCard.RecipientPhone != "" {
+		if err := giftCardService.smsService.SendGiftCard(giftCard.RecipientPhone, code, strconv.FormatUint(uint64(giftCard.Value), 10)); err != nil {
+			return err
+		}
+	}
+	if giftCard.RecipientEmail != "" {
+		data := struct {
+			RecipientName string
+			Value         uint
+			Code          string
+			ExpiresAt     string
+			Year          int
+		}{
+			RecipientName: giftCard.RecipientName,
+			Value:         giftCard.Value,
+			Code:          code,
+			ExpiresAt:     giftCard.ExpiresAt.Format("2006-01-02"),
+			Year:          time.Now().Year(),
+		}
+		if err := giftCardService.emailService.SendEmail(giftCard.RecipientEmail, emailSubject, templateFile, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordDelivery sends the card and stores the outcome on it, so a failed
+// delivery shows up in the admin list and can be resent.
+func (giftCardService *GiftCardService) recordDelivery(giftCard *entity.GiftCard, templateFile, emailSubject string) error {
+	deliveryErr := giftCardService.deliverGiftCard(giftCard, templateFile, emailSubject)
+	if deliveryErr != nil {
+		deliveryError := []rune(deliveryErr.Error())
+		if len(deliveryError) > 255 {
+			deliveryError = deliveryError[:255]
+		}
+		giftCard.DeliveredAt = nil
+		giftCard.DeliveryError = string(deliveryError)
+	} else {
+		now := time.Now()
+		giftCard.DeliveredAt = &now
+		giftCard.DeliveryError = ""
+	}
+
+	if err := giftCardService.giftCardRepository.UpdateGiftCardDelivery(giftCardService.db, giftCard.ID, giftCard.DeliveredAt, giftCard.DeliveryError); err != nil {
+		return err
+	}
+	if deliveryErr != nil {
+		return exception.DeliveryError{Item: giftCardService.constants.Field.GiftCard, Err: deliveryErr}
+	}
+	return nil
+}
+
+// IssueGiftCard commits the card before delivering it. A failed delivery does
+// not undo the issue, since the recipient may already hold the code.
+func (giftCardService *GiftCardService) IssueGiftCard(request giftcarddto.IssueGiftCardRequest) (giftcarddto.IssuedGiftCardResponse, error) {
+	if err := giftCardService.userService.IsUserActive(request.IssuerID); err != nil {
+		return giftcarddto.IssuedGiftCardResponse{}, err
+	}
+
+	if !request.ExpiresAt.After(time.Now()) {
+		var validationErrors exception.ValidationErrors
+		validationErrors.Add(giftCardService.constants.Field.GiftCard, giftCardService.constants.Tag.ExpiredItem)
+		return giftcarddto.IssuedGiftCardResponse{}, validationErrors
+	}
+
+	code, err := giftCardService.generateGiftCardCode()
+	if err != nil {
+		return giftcarddto.IssuedGiftCardResponse{}, err
+	}
+
+	giftCard := &entity.GiftCard{
+		Code:           code,
+		Value:          request.Value,
+		Balance:        request.Value,
+		ExpiresAt:      request.ExpiresAt,
+		Status:         enum.GiftCardStatusActive,
+		IssuerID:       request.IssuerID,
+		RecipientName:  request.RecipientName,
+		RecipientPhone: request.RecipientPhone,
+		RecipientEmail: request.RecipientEmail,
+	}
+	err = giftCardService.db.WithTransaction(func(tx database.Database) error {
+		if err := giftCardService.giftCardRepository.CreateGiftCard(tx, giftCard); err != nil {
+			return err
+		}
+
+		transaction := &entity.GiftCardTransaction{
+			GiftCardID:   giftCard.ID,
+			Type:         enum.GiftCardTransactionIssue,
+			Amount:       giftCard.Value,
+			BalanceAfter: giftCard.Balance,
+			ActorID:      &request.IssuerID,
+		}
+		return giftCardService.giftCardRepository.CreateGiftCardTransaction(tx, transaction)
+	})
+	if err != nil {
+		return giftcarddto.IssuedGiftCardResponse{}, err
+	}
+
+	// The outcome is kept on the card and reported through Delivered. A card that
+	// could not even record it stays undelivered and can be resent.
+	if err := giftCardService.recordDelivery(giftCard, request.TemplateFile, request.EmailSubject); err != nil {
+		giftCardService.logger.Error("gift card delivery failed", logger.Int("giftCardID", int(giftCard.ID)), logger.Error("error", err))
+	}
+
+	return giftcarddto.IssuedGiftCardResponse{
+		ID:        giftCard.ID,
+		Code:      giftCardService.formatCode(giftCard.Code),
+		Value:     giftCard.Value,
+		ExpiresAt: giftCard.ExpiresAt,
+		Delivered: giftCard.DeliveredAt != nil,
+	}, nil
+}
+
+func (giftCardService *GiftCardService) ResendGiftCard(request giftcarddto.ResendGiftCardRequest) error {
+	giftCard, err := giftCardService.getGiftCardByID(request.GiftCardID)
+	if err != nil {
+		return err
+	}
+	if err := giftCardService.checkGiftCardUsable(giftCard); err != nil {
+		return err
+	}
+
+	return giftCardService.recordDelivery(giftCard, request.TemplateFile, request.EmailSubject)
+}
+
+func (giftCardService *GiftCardService) GetGiftCardBalance(request giftcarddto.GetGiftCardBalanceRequest) (giftcarddto.GiftCardBalanceResponse, error) {
+	limit := giftCardService.giftCardConfig.LookupLimit
+	window := time.Duration(giftCardService.giftCardConfig.LookupWindowMinutes) * time.Minute
+	redisKey := giftCardService.constants.RedisKey.GenerateGiftCardLookupKey(request.UserID)
+	attempts, err := giftCardService.rateLimitCacheRepository.Increment(context.Background(), redisKey, window)
+	if err != nil {
+		return giftcarddto.GiftCardBalanceResponse{}, err
+	}
+	if attempts > int64(limit) {
+		return giftcarddto.GiftCardBalanceResponse{}, exception.NewRequestRateLimitError("", limit, nil)
+	}
+
+	giftCard, err := giftCardService.giftCardRepository.FindGiftCardByCode(giftCardService.db, giftCardService.normalizeCode(request.Code))
+	if err != nil {
+		return giftcarddto.GiftCardBalanceResponse{}, err
+	}
+	if giftCard == nil {
+		notFoundError := exception.NotFoundError{Item: giftCardService.constants.Field.GiftCard}
+		return giftcarddto.GiftCardBalanceResponse{}, notFoundError
+	}
+
+	return giftcarddto.GiftCardBalanceResponse{
+		Value:     giftCard.Value,
+		Balance:   giftCard.Balance,
+		ExpiresAt: giftCard.ExpiresAt,
+		Status:    giftCard.Status.String(),
+	}, nil
+}
+
+// RedeemGiftCard deducts the given amount from the card balance and returns the
+// amount actually covered by the card, so it can be used partially across orders.
+func (giftCardService *GiftCardService) RedeemGiftCard(request giftcarddto.RedeemGiftCardRequest) (uint, error) {
+	var redeemed uint
+	err := giftCardService.db.WithTransaction(func(tx database.Database) error {
+		giftCard, err := giftCardService.giftCardRepository.FindGiftCardByCodeForUpdate(tx, giftCardService.normalizeCode(request.Code))
+		if err != nil {
+			return err
+		}
+		if giftCard == nil {
+			notFoundError := exception.NotFoundError{Item: giftCardService.constants.Field.GiftCard}
+			return notFoundError
+		}
+		if err := giftCardService.checkGiftCardUsable(giftCard); err != nil {
+			return err
+		}
+		if giftCard.Balance == 0 {
+			var conflictErrors exception.ConflictErrors
+			conflictErrors.Add(giftCardService.constants.Field.GiftCard, giftCardService.constants.Tag.InsufficientBalance)
+			return conflictErrors
+		}
+
+		redeemed = min(request.Amount, giftCard.Balance)
+		giftCard.Balance -= redeemed
+		if err := giftCardService.giftCardRepository.UpdateGiftCard(tx, giftCard); err != nil {
+			return err
+		}
+
+		transaction := &entity.GiftCardTransaction{
+			GiftCardID:   giftCard.ID,
+			Type:         enum.GiftCardTransactionRedeem,
+			Amount:       redeemed,
+			BalanceAfter: giftCard.Balance,
+			Reference:    request.Reference,
+			ActorID:      request.ActorID,
+		}
+		return giftCardService.giftCardRepository.CreateGiftCardTransaction(tx, transaction)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return redeemed, nil
+}
+
+func (giftCardService *GiftCardService) GetGiftCards(request giftcarddto.GetGiftCardsRequest) ([]giftcarddto.GiftCardResponse, error) {
+	paginationModifier := postgresImpl.NewPaginationModifier(request.Limit, request.Offset)
+	sortingModifier := postgresImpl.NewSortingModifier("created_at", true)
+
+	allowedStatuses := giftCardService.mapToFilterStatuses(request.Status)
+	giftCards, err := giftCardService.giftCardRepository.FindGiftCardsByStatus(giftCardService.db, allowedStatuses, paginationModifier, sortingModifier)
+	if err != nil {
+		return nil, err
+	}
+
+	giftCardsResponse := make([]giftcarddto.GiftCardResponse, len(giftCards))
+	for i, giftCard := range giftCards {
+		giftCardsResponse[i] = giftcarddto.GiftCardResponse{
+			ID:             giftCard.ID,
+			Code:           giftCardService.maskCode(giftCard.Code),
+			Value:          giftCard.Value,
+			Balance:        giftCard.Balance,
+			ExpiresAt:      giftCard.ExpiresAt,
+			Status:         giftCard.Status.String(),
+			RecipientName:  giftCard.RecipientName,
+			RecipientPhone: giftCard.RecipientPhone,
+			RecipientEmail: giftCard.RecipientEmail,
+			DeliveredAt:    giftCard.DeliveredAt,
+			DeliveryError:  giftCard.DeliveryError,
+			CreatedAt:      giftCard.CreatedAt,
+		}
+	}
+	return giftCardsResponse, nil
+}
+
+func (giftCardService *GiftCardService) getGiftCardByID(giftCardID uint) (*entity.GiftCard, error) {
+	giftCard, err := giftCardService.giftCardRepository.FindGiftCardByID(giftCardService.db, giftCardID)
+	if err != nil {
+		return nil, err
+	}
+	if giftCard == nil {
+		notFoundError := exception.NotFoundError{Item: giftCardService.constants.Field.GiftCard}
+		return nil, notFoundError
+	}
+	return giftCard, nil
+}
+
+func (giftCardService *GiftCardService) GetGiftCardTransactions(request giftcarddto.GetGiftCardTransactionsRequest) ([]giftcarddto.GiftCardTransactionResponse, error) {
+	if _, err := giftCardService.getGiftCardByID(request.GiftCardID); err != nil {
+		return nil, err
+	}
+
+	paginationModifier := postgresImpl.NewPaginationModifier(request.Limit, request.Offset)
+	sortingModifier := postgresImpl.NewSortingModifier("created_at", true)
+	transactions, err := giftCardService.giftCardRepository.FindGiftCardTransactions(giftCardService.db, request.GiftCardID, paginationModifier, sortingModifier)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsResponse := make([]giftcarddto.GiftCardTransactionResponse, len(transactions))
+	for i, transaction := range transactions {
+		transactionsResponse[i] = giftcarddto.GiftCardTransactionResponse{
+			ID:           transaction.ID,
+			Type:         transaction.Type.String(),
+			Amount:       transaction.Amount,
+			BalanceAfter: transaction.BalanceAfter,
+			Reference:    transaction.Reference,
+			CreatedAt:    transaction.CreatedAt,
+		}
+	}
+	return transactionsResponse, nil
+}
+
+func (giftCardService *GiftCardService) DisableGiftCard(giftCardID uint) error {
+	giftCard, err := giftCardService.getGiftCardByID(giftCardID)
+	if err != nil {
+		return err
+	}
+	if giftCard.Status == enum.GiftCardStatusDisabled {
+		var conflictErrors exception.ConflictErrors
+		conflictErrors.Add(giftCardService.constants.Field.GiftCard, giftCardService.constants.Tag.StatusNotChange)
+		return conflictErrors
+	}
+
+	giftCard.Status = enum.GiftCardStatusDisabled
+	return giftCardService.giftCardRepository.UpdateGiftCard(giftCardService.db, giftCard)
+}
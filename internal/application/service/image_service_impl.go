@@ -0,0 +1,275 @@
+package service
+
+import (
+	"io"
+	"mime/multipart"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/imaging"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/logger"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/s3"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type imageJob struct {
+	bucketType enum.BucketType
+	key        string
+	data       []byte
+}
+
+type ImageService struct {
+	constants                *bootstrap.Constants
+	imageConfig              *bootstrap.ImageProcessing
+	logger                   logger.Logger
+	imageProcessor           imaging.ImageProcessor
+	s3Storage                s3.S3Storage
+	imageRenditionRepository postgres.ImageRenditionRepository
+	db                       database.Database
+	jobs                     chan imageJob
+}
+
+func NewImageService(
+	constants *bootstrap.Constants,
+	imageConfig *bootstrap.ImageProcessing,
+	logger logger.Logger,
+	imageProcessor imaging.ImageProcessor,
+	s3Storage s3.S3Storage,
+	imageRenditionRepository postgres.ImageRenditionRepository,
+	db database.Database,
+) *ImageService {
+	imageService := &ImageService{
+		constants:                constants,
+		imageConfig:              imageConfig,
+		logger:                   logger,
+		imageProcessor:           imageProcessor,
+		s3Storage:                s3Storage,
+		imageRenditionRepository: imageRenditionRepository,
+		db:                       db,
+		jobs:                     make(chan imageJob, imageConfig.QueueSize),
+	}
+	for i := 0; i < imageConfig.Workers; i++ {
+		go imageService.worker()
+	}
+	return imageService
+}
+
+func (imageService *ImageService) worker() {
+	for job := range imageService.jobs {
+		imageService.handle(job)
+	}
+}
+
+func (imageService *ImageService) handle(job imageJob) {
+	if err := imageService.process(job); err != nil {
+		imageService.logger.Error("image processing failed",
+			logger.String("bucket", job.bucketType.String()),
+			logger.String("key", job.key),
+			logger.Error("error", err),
+		)
+	}
+}
+
+func (imageService *ImageService) sourceExists(job imageJob) (bool, error) {
+	info, err := imageService.s3Storage.HeadObject(job.bucketType, job.key)
+	if err != nil {
+		return false, err
+	}
+	return info != nil, nil
+}
+
+// discardRenditions removes renditions stored for an original that was deleted
+// while it was being processed.
+func (imageService *ImageService) discardRenditions(job imageJob, renditions []*entity.ImageRendition) error {
+	for _, rendition := range renditions {
+		if err := imageService.s3Storage.DeleteObject(job.bucketType, rendition.Key); err != nil {
+			return err
+		}
+	}
+	return imageService.imageRenditionRepository.DeleteImageRenditions(imageService.db, job.bucketType, job.key)
+}
+
+// process stores the renditions and checks afterwards that the original was not
+// deleted meanwhile, so a DeleteImage that runs during processing does not leave
+// renditions behind.
+func (imageService *ImageService) process(job imageJob) error {
+	exists, err := imageService.sourceExists(job)
+	if err != nil || !exists {
+		return err
+	}
+
+	processed, err := imageService.imageProcessor.Process(job.data, imageService.constants.ImageRenditions.GetWidths())
+	if err != nil {
+		return err
+	}
+
+	renditions := make([]*entity.ImageRendition, 0, 2*len(processed.Renditions)+1)
+	storeRendition := func(name, renditionKey string, image imaging.Image) error {
+		if err := imageService.s3Storage.PutObject(job.bucketType, renditionKey, image.Data, image.ContentType); err != nil {
+			return err
+		}
+		renditions = append(renditions, &entity.ImageRendition{
+			BucketType:  job.bucketType,
+			OriginalKey: job.key,
+			Name:        name,
+			Key:         renditionKey,
+			Width:       image.Width,
+			Height:      image.Height,
+		})
+		return nil
+	}
+
+	for name, image := range processed.Renditions {
+		if err := storeRendition(name, imageService.constants.S3BucketPath.GetImageRenditionPath(job.key, name), image); err != nil {
+			return err
+		}
+	}
+	processed.WebP[imageService.constants.ImageRenditions.Original] = processed.OriginalWebP
+	for name, image := range processed.WebP {
+		webPName := imageService.constants.ImageRenditions.GetWebPName(name)
+		if err := storeRendition(webPName, imageService.constants.S3BucketPath.GetWebPRenditionPath(job.key, name), image); err != nil {
+			return err
+		}
+	}
+
+	err = imageService.db.WithTransaction(func(tx database.Database) error {
+		if err := imageService.imageRenditionRepository.DeleteImageRenditions(tx, job.bucketType, job.key); err != nil {
+			return err
+		}
+		if len(renditions) == 0 {
+			return nil
+		}
+		return imageService.imageRenditionRepository.CreateImageRenditions(tx, renditions)
+	})
+	if err != nil {
+		return err
+	}
+
+	exists, err = imageService.sourceExists(job)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return imageService.discardRenditions(job, renditions)
+	}
+	return nil
+}
+
+// storeSanitized strips the metadata of a JPEG or PNG and caps its size before it
+// is stored, so an original is never served with its EXIF or GPS data. Only the
+// renditions are left to the background workers.
+func (imageService *ImageService) storeSanitized(bucketType enum.BucketType, key string, data []byte) error {
+	sanitized, err := imageService.imageProcessor.Sanitize(data, imageService.imageConfig.MaxDimension)
+	if err != nil {
+		return err
+	}
+	if err := imageService.s3Storage.PutObject(bucketType, key, sanitized.Data, sanitized.ContentType); err != nil {
+		return err
+	}
+
+	imageService.enqueue(imageJob{
+		bucketType: bucketType,
+		key:        key,
+		data:       sanitized.Data,
+	})
+	return nil
+}
+
+// UploadImage stores a metadata-free, size-capped copy of the file and queues it
+// for renditions. Files that are not JPEG or PNG are stored as they are.
+func (imageService *ImageService) UploadImage(bucketType enum.BucketType, key string, file *multipart.FileHeader) error {
+	fileReader, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer fileReader.Close()
+
+	data, err := io.ReadAll(fileReader)
+	if err != nil {
+		return err
+	}
+
+	if !imageService.imageProcessor.IsSupported(data) {
+		return imageService.s3Storage.UploadObject(bucketType, key, file)
+	}
+	return imageService.storeSanitized(bucketType, key, data)
+}
+
+// ProcessStoredImage sanitizes an object that is already in storage, such as one
+// uploaded directly by the client, in place and queues it like UploadImage.
+func (imageService *ImageService) ProcessStoredImage(bucketType enum.BucketType, key string) error {
+	data, err := imageService.s3Storage.GetObject(bucketType, key)
+	if err != nil {
+		return err
+	}
+	if !imageService.imageProcessor.IsSupported(data) {
+		return nil
+	}
+	return imageService.storeSanitized(bucketType, key, data)
+}
+
+// enqueue waits a while for room in the queue and then processes the job on the
+// caller's goroutine, so a burst of uploads slows down instead of losing jobs.
+func (imageService *ImageService) enqueue(job imageJob) {
+	timeout := time.NewTimer(time.Duration(imageService.imageConfig.EnqueueTimeoutSeconds) * time.Second)
+	defer timeout.Stop()
+
+	select {
+	case imageService.jobs <- job:
+		return
+	case <-timeout.C:
+	}
+
+	imageService.logger.Warn("image processing queue is full, processing inline",
+		logger.String("bucket", job.bucketType.String()),
+		logger.String("key", job.key),
+	)
+	imageService.handle(job)
+}
+
+func (imageService *ImageService) GetImageURLs(bucketType enum.BucketType, key string, expiration time.Duration) (map[string]string, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	originalURL, err := imageService.s3Storage.GetPresignedURL(bucketType, key, expiration)
+	if err != nil {
+		return nil, err
+	}
+	urls := map[string]string{
+		imageService.constants.ImageRenditions.Original: originalURL,
+	}
+
+	renditions, err := imageService.imageRenditionRepository.FindImageRenditions(imageService.db, bucketType, key)
+	if err != nil {
+		return nil, err
+	}
+	for _, rendition := range renditions {
+		renditionURL, err := imageService.s3Storage.GetPresignedURL(bucketType, rendition.Key, expiration)
+		if err != nil {
+			return nil, err
+		}
+		urls[rendition.Name] = renditionURL
+	}
+	return urls, nil
+}
+
+func (imageService *ImageService) DeleteImage(bucketType enum.BucketType, key string) error {
+	renditions, err := imageService.imageRenditionRepository.FindImageRenditions(imageService.db, bucketType, key)
+	if err != nil {
+		return err
+	}
+	for _, rendition := range renditions {
+		if err := imageService.s3Storage.DeleteObject(bucketType, rendition.Key); err != nil {
+			return err
+		}
+	}
+
+	if err := imageService.imageRenditionRepository.DeleteImageRenditions(imageService.db, bucketType, key); err != nil {
+		return err
+	}
+	return imageService.s3Storage.DeleteObject(bucketType, key)
+}
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"slices"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	ingredientdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/ingredient"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	postgresImpl "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
+)
+
+type IngredientService struct {
+	constants            *bootstrap.Constants
+	userService          usecase.UserService
+	userRepository       postgres.UserRepository
+	ingredientRepository postgres.IngredientRepository
+	db                   database.Database
+}
+
+func NewIngredientService(
+	constants *bootstrap.Constants,
+	userService usecase.UserService,
+	userRepository postgres.UserRepository,
+	ingredientRepository postgres.IngredientRepository,
+	db database.Database,
+) *IngredientService {
+	return &IngredientService{
+		constants:            constants,
+		userService:          userService,
+		userRepository:       userRepository,
+		ingredientRepository: ingredientRepository,
+		db:                   db,
+	}
+}
+
+func (ingredientService *IngredientService) mapToIngredientResponse(ingredient entity.Ingredient) ingredientdto.IngredientResponse {
+	return ingredientdto.IngredientResponse{
+		ID:                ingredient.ID,
+		INCIName:          ingredient.INCIName,
+		PersianName:       ingredient.PersianName,
+		IsParaben:         ingredient.IsParaben,
+		IsFragrance:       ingredient.IsFragrance,
+		ComedogenicRating: ingredient.ComedogenicRating,
+	}
+}
+
+func (ingredientService *IngredientService) getIngredientByID(ingredientID uint) (*entity.Ingredient, error) {
+	ingredient, err := ingredientService.ingredientRepository.FindIngredientByID(ingredientService.db, ingredientID)
+	if err != nil {
+		return nil, err
+	}
+	if ingredient == nil {
+		notFoundError := exception.NotFoundError{Item: ingredientService.constants.Field.Ingredient}
+		return nil, notFoundError
+	}
+	return ingredient, nil
+}
+
+func (ingredientService *IngredientService) getIngredientsByIDs(ingredientIDs []uint) ([]entity.Ingredient, error) {
+	if len(ingredientIDs) == 0 {
+		return []entity.Ingredient{}, nil
+	}
+
+	ingredients, err := ingredientService.ingredientRepository.FindIngredientsByIDs(ingredientService.db, ingredientIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(ingredients) != len(slices.Compact(slices.Sorted(slices.Values(ingredientIDs)))) {
+		notFoundError := exception.NotFoundError{Item: ingredientService.constants.Field.Ingredient}
+		return nil, notFoundError
+	}
+	return ingredients, nil
+}
+
+func (ingredientService *IngredientService) checkDuplicateIngredient(inciName string, ingredientID uint) error {
+	ingredient, err := ingredientService.ingredientRepository.FindIngredientByINCIName(ingredientService.db, inciName)
+	if err != nil {
+		return err
+	}
+	if ingredient != nil && ingredient.ID != ingredientID {
+		var conflictErrors exception.ConflictErrors
+		conflictErrors.Add(ingredientService.constants.Field.INCIName, ingredientService.constants.Tag.AlreadyExist)
+		return conflictErrors
+	}
+	return nil
+}
+
+func (ingredientService *IngredientService) GetIngredients(request ingredientdto.GetIngredientsRequest) ([]ingredientdto.IngredientResponse, error) {
+	paginationModifier := postgresImpl.NewPaginationModifier(request.Limit, request.Offset)
+	sortingModifier := postgresImpl.NewSortingModifier("inci_name", false)
+
+	ingredients, err := ingredientService.ingredientRepository.FindIngredients(ingredientService.db, request.Search, paginationModifier, sortingModifier)
+	if err != nil {
+		return nil, err
+	}
+
+	ingredientsResponse := make([]ingredientdto.IngredientResponse, len(ingredients))
+	for i, ingredient := range ingredients {
+		ingredientsResponse[i] = ingredientService.mapToIngredientResponse(*ingredient)
+	}
+	return ingredientsResponse, nil
+}
+
+func (ingredientService *IngredientService) GetIngredient(ingredientID uint) (ingredientdto.IngredientResponse, error) {
+	ingredient, err := ingredientService.getIngredientByID(ingredientID)
+	if err != nil {
+		return ingredientdto.IngredientResponse{}, err
+	}
+	return ingredientService.mapToIngredientResponse(*ingredient), nil
+}
+
+func (ingredientService *IngredientService) CreateIngredient(request ingredientdto.CreateIngredientRequest) (uint, error) {
+	if err := ingredientService.checkDuplicateIngredient(request.INCIName, 0); err != nil {
+		return 0, err
+	}
+
+	ingredient := &entity.Ingredient{
+		INCIName:          request.INCIName,
+		PersianName:       request.PersianName,
+		IsParaben:         request.IsParaben,
+		IsFragrance:       request.IsFragrance,
+		ComedogenicRating: request.ComedogenicRating,
+	}
+	if err := ingredientService.ingredientRepository.CreateIngredient(ingredientService.db, ingredient); err != nil {
+		return 0, err
+	}
+	return ingredient.ID, nil
+}
+
+func (ingredientService *IngredientService) UpdateIngredient(request ingredientdto.UpdateIngredientRequest) error {
+	ingredient, err := ingredientService.getIngredientByID(request.IngredientID)
+	if err != nil {
+		return err
+	}
+
+	if request.INCIName != nil {
+		if err := ingredientService.checkDuplicateIngredient(*request.INCIName, ingredient.ID); err != nil {
+			return err
+		}
+		ingredient.INCIName = *request.INCIName
+	}
+
+	if request.PersianName != nil {
+		ingredient.PersianName = *request.PersianName
+	}
+
+	if request.IsParaben != nil {
+		ingredient.IsParaben = *request.IsParaben
+	}
+
+	if request.IsFragrance != nil {
+		ingredient.IsFragrance = *request.IsFragrance
+	}
+
+	if request.ComedogenicRating != nil {
+		ingredient.ComedogenicRating = *request.ComedogenicRating
+	}
+
+	return ingredientService.ingredientRepository.UpdateIngredient(ingredientService.db, ingredient)
+}
+
+func (ingredientService *IngredientService) DeleteIngredient(ingredientID uint) error {
+	if _, err := ingredientService.getIngredientByID(ingredientID); err != nil {
+		return err
+	}
+	return ingredientService.ingredientRepository.DeleteIngredient(ingredientService.db, ingredientID)
+}
+
+func (ingredientService *IngredientService) GetAllSkinTypes() []ingredientdto.SkinTypesResponse {
+	skinTypes := enum.GetAllSkinTypes()
+	skinTypesResponse := make([]ingredientdto.SkinTypesResponse, len(skinTypes))
+	for i, skinType := range skinTypes {
+		skinTypesResponse[i] = ingredientdto.SkinTypesResponse{
+			ID:   uint(skinType),
+			Name: skinType.String(),
+		}
+	}
+	return skinTypesResponse
+}
+
+func (ingredientService *IngredientService) GetAllSkinConcerns() []ingredientdto.SkinConcernsResponse {
+	concerns := enum.GetAllSkinConcerns()
+	concernsResponse := make([]ingredientdto.SkinConcernsResponse, len(concerns))
+	for i, concern := range concerns {
+		concernsResponse[i] = ingredientdto.SkinConcernsResponse{
+			ID:   uint(concern),
+			Name: concern.String(),
+		}
+	}
+	return concernsResponse
+}
+
+func (ingredientService *IngredientService) GetSkinProfile(userID uint) (ingredientdto.SkinProfileResponse, error) {
+	user, err := ingredientService.userService.GetUserByID(userID)
+	if err != nil {
+		return ingredientdto.SkinProfileResponse{}, err
+	}
+
+	allergies, err := ingredientService.ingredientRepository.FindUserAllergies(ingredientService.db, userID)
+	if err != nil {
+		return ingredientdto.SkinProfileResponse{}, err
+	}
+	concerns, err := ingredientService.ingredientRepository.FindUserSkinConcerns(ingredientService.db, userID)
+	if err != nil {
+		return ingredientdto.SkinProfileResponse{}, err
+	}
+
+	var skinType *ingredientdto.SkinTypesResponse
+	if user.SkinType != nil {
+		skinType = &ingredientdto.SkinTypesResponse{
+			ID:   uint(*user.SkinType),
+			Name: user.SkinType.String(),
+		}
+	}
+
+	allergiesResponse := make([]ingredientdto.IngredientResponse, len(allergies))
+	for i, allergy := range allergies {
+		allergiesResponse[i] = ingredientService.mapToIngredientResponse(allergy)
+	}
+
+	concernsResponse := make([]ingredientdto.SkinConcernsResponse, len(concerns))
+	for i, concern := range concerns {
+		concernsResponse[i] = ingredientdto.SkinConcernsResponse{
+			ID:   uint(concern),
+			Name: concern.String(),
+		}
+	}
+
+	return ingredientdto.SkinProfileResponse{
+		SkinType:  skinType,
+		Allergies: allergiesResponse,
+		Concerns:  concernsResponse,
+	}, nil
+}
+
+func (ingredientService *IngredientService) mapToSkinType(skinTypeID uint) (enum.SkinType, error) {
+	for _, skinType := range enum.GetAllSkinTypes() {
+		if uint(skinType) == skinTypeID {
+			return skinType, nil
+		}
+	}
+	var validationErrors exception.ValidationErrors
+	validationErrors.Add(ingredientService.constants.Field.SkinType, ingredientService.constants.Tag.NotExist)
+	return 0, validationErrors
+}
+
+func (ingredientService *IngredientService) mapToSkinConcerns(concernIDs []uint) ([]enum.SkinConcern, error) {
+	concerns := make([]enum.SkinConcern, 0, len(concernIDs))
+	for _, concernID := range concernIDs {
+		concern := enum.SkinConcern(concernID)
+		if !slices.Contains(enum.GetAllSkinConcerns(), concern) {
+			var validationErrors exception.ValidationErrors
+			validationErrors.Add(ingredientService.constants.Field.SkinConcern, ingredientService.constants.Tag.NotExist)
+			return nil, validationErrors
+		}
+		if !slices.Contains(concerns, concern) {
+			concerns = append(concerns, concern)
+		}
+	}
+	return concerns, nil
+}
+
+func (ingredientService *IngredientService) UpdateSkinProfile(request ingredientdto.UpdateSkinProfileRequest) error {
+	user, err := ingredientService.userService.GetUserByID(request.UserID)
+	if err != nil {
+		return err
+	}
+
+	if request.SkinType != nil {
+		skinType, err := ingredientService.mapToSkinType(*request.SkinType)
+		if err != nil {
+			return err
+		}
+		user.SkinType = &skinType
+	}
+
+	var allergies []entity.Ingredient
+	if request.AllergyIDs != nil {
+		allergies, err = ingredientService.getIngredientsByIDs(request.AllergyIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	var concerns []enum.SkinConcern
+	if request.Concerns != nil {
+		concerns, err = ingredientService.mapToSkinConcerns(request.Concerns)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = ingredientService.db.WithTransaction(func(tx database.Database) error {
+		if request.SkinType != nil {
+			if err := ingredientService.userRepository.UpdateUser(tx, user); err != nil {
+				return err
+			}
+		}
+
+		if request.AllergyIDs != nil {
+			if err := ingredientService.ingredientRepository.ReplaceUserAllergies(tx, user, allergies); err != nil {
+				return err
+			}
+		}
+
+		if request.Concerns != nil {
+			if err := ingredientService.ingredientRepository.ReplaceUserSkinConcerns(tx, user.ID, concerns); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return err
+}
+
+// CheckIngredients scores a set of ingredients against the user's skin profile
+// from 0 to 100 and lists the ingredients that caused a deduction.
+func (ingredientService *IngredientService) CheckIngredients(request ingredientdto.CheckIngredientsRequest) (ingredientdto.SuitabilityResponse, error) {
+	user, err := ingredientService.userService.GetUserByID(request.UserID)
+	if err != nil {
+		return ingredientdto.SuitabilityResponse{}, err
+	}
+	ingredients, err := ingredientService.getIngredientsByIDs(request.IngredientIDs)
+	if err != nil {
+		return ingredientdto.SuitabilityResponse{}, err
+	}
+	allergies, err := ingredientService.ingredientRepository.FindUserAllergies(ingredientService.db, user.ID)
+	if err != nil {
+		return ingredientdto.SuitabilityResponse{}, err
+	}
+	concerns, err := ingredientService.ingredientRepository.FindUserSkinConcerns(ingredientService.db, user.ID)
+	if err != nil {
+		return ingredientdto.SuitabilityResponse{}, err
+	}
+
+	allergyIDs := make([]uint, len(allergies))
+	for i, allergy := range allergies {
+		allergyIDs[i] = allergy.ID
+	}
+	sensitiveSkin := (user.SkinType != nil && *user.SkinType == enum.SkinTypeSensitive) ||
+		slices.Contains(concerns, enum.SkinConcernRedness)
+	acneProneSkin := (user.SkinType != nil && (*user.SkinType == enum.SkinTypeOily || *user.SkinType == enum.SkinTypeCombination)) ||
+		slices.Contains(concerns, enum.SkinConcernAcne) ||
+		slices.Contains(concerns, enum.SkinConcernPores)
+
+	score := 100
+	warnings := []ingredientdto.IngredientWarningResponse{}
+	addWarning := func(ingredient entity.Ingredient, warning enum.IngredientWarning, penalty int) {
+		score -= penalty
+		warnings = append(warnings, ingredientdto.IngredientWarningResponse{
+			Ingredient: ingredientService.mapToIngredientResponse(ingredient),
+			Reason:     warning.String(),
+		})
+	}
+	for _, ingredient := range ingredients {
+		if slices.Contains(allergyIDs, ingredient.ID) {
+			addWarning(ingredient, enum.IngredientWarningAllergen, 50)
+		}
+		if ingredient.IsFragrance && sensitiveSkin {
+			addWarning(ingredient, enum.IngredientWarningFragrance, 15)
+		}
+		if ingredient.ComedogenicRating >= 3 && acneProneSkin {
+			addWarning(ingredient, enum.IngredientWarningComedogenic, 10*int(ingredient.ComedogenicRating-2))
+		}
+	}
+
+	return ingredientdto.SuitabilityResponse{
+		Score:    max(score, 0),
+		Warnings: warnings,
+	}, nil
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/metrics"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/redis"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+const analyticsDateLayout = "2006-01-02"
+
+type NewsAnalyticsService struct {
+	constants               *bootstrap.Constants
+	newsConfig              *bootstrap.News
+	newsService             usecase.NewsService
+	newsRepository          postgres.NewsRepository
+	newsCommentRepository   postgres.NewsCommentRepository
+	newsViewCacheRepository redis.NewsViewCacheRepository
+	metricsClient           metrics.MetricsClient
+	db                      database.Database
+}
+
+func NewNewsAnalyticsService(
+	constants *bootstrap.Constants,
+	newsConfig *bootstrap.News,
+	newsService usecase.NewsService,
+	newsRepository postgres.NewsRepository,
+	newsCommentRepository postgres.NewsCommentRepository,
+	newsViewCacheRepository redis.NewsViewCacheRepository,
+	metricsClient metrics.MetricsClient,
+	db database.Database,
+) *NewsAnalyticsService {
+	return &NewsAnalyticsService{
+		constants:               constants,
+		newsConfig:              newsConfig,
+		newsService:             newsService,
+		newsRepository:          newsRepository,
+		newsCommentRepository:   newsCommentRepository,
+		newsViewCacheRepository: newsViewCacheRepository,
+		metricsClient:           metricsClient,
+		db:                      db,
+	}
+}
+
+func (analyticsService *NewsAnalyticsService) startOfWindow(days int) time.Time {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return today.AddDate(0, 0, 1-days)
+}
+
+// RecordNewsView counts a view at most once per visitor within the dedup
+// window. Counted views are buffered in Redis until FlushNewsViews runs.
+func (analyticsService *NewsAnalyticsService) RecordNewsView(request newsdto.RecordNewsViewRequest) error {
+	ctx := context.Background()
+	visitorHash := sha256.Sum256([]byte(request.VisitorID))
+	viewKey := analyticsService.constants.RedisKey.GenerateNewsViewKey(request.NewsID, hex.EncodeToString(visitorHash[:16]))
+	window := time.Duration(analyticsService.newsConfig.ViewDedupMinutes) * time.Minute
+	firstView, err := analyticsService.newsViewCacheRepository.MarkViewed(ctx, viewKey, window)
+	if err != nil {
+		return err
+	}
+	if !firstView {
+		return nil
+	}
+
+	bufferKey := analyticsService.constants.RedisKey.GenerateNewsViewBufferKey()
+	field := fmt.Sprintf("%d:%s", request.NewsID, time.Now().Format(analyticsDateLayout))
+	if err := analyticsService.newsViewCacheRepository.IncrementPendingViews(ctx, bufferKey, field, 1); err != nil {
+		return err
+	}
+	analyticsService.metricsClient.IncBusinessEvent("news", "view")
+	return nil
+}
+
+// FlushNewsViews moves buffered views into the daily counters. Counters that
+// could not be written are put back into the buffer for the next run.
+func (analyticsService *NewsAnalyticsService) FlushNewsViews() error {
+	ctx := context.Background()
+	bufferKey := analyticsService.constants.RedisKey.GenerateNewsViewBufferKey()
+	pending, err := analyticsService.newsViewCacheRepository.PopPendingViews(ctx, bufferKey)
+	if err != nil {
+		return err
+	}
+
+	var flushErr error
+	for field, views := range pending {
+		if flushErr == nil {
+			flushErr = analyticsService.flushNewsViewField(field, views)
+			if flushErr == nil {
+				continue
+			}
+		}
+		if err := analyticsService.newsViewCacheRepository.IncrementPendingViews(ctx, bufferKey, field, views); err != nil {
+			return err
+		}
+	}
+	return flushErr
+}
+
+func (analyticsService *NewsAnalyticsService) flushNewsViewField(field string, views int64) error {
+	newsIDText, dayText, found := strings.Cut(field, ":")
+	if !found {
+		return nil
+	}
+	newsID, err := strconv.ParseUint(newsIDText, 10, 64)
+	if err != nil {
+		return nil
+	}
+	day, err := time.Parse(analyticsDateLayout, dayText)
+	if err != nil {
+		return nil
+	}
+
+	news, err := analyticsService.newsRepository.FindNewsByID(analyticsService.db, uint(newsID))
+	if err != nil {
+		return err
+	}
+	if news == nil {
+		return nil
+	}
+	return analyticsService.newsRepository.IncrementNewsDailyViews(analyticsService.db, news.ID, day, views)
+}
+
+// GetNewsAnalytics reports totals and a per day series for the last days. Views
+// still waiting in the buffer show up after the next flush.
+func (analyticsService *NewsAnalyticsService) GetNewsAnalytics(request newsdto.GetNewsAnalyticsRequest) (newsdto.NewsAnalyticsResponse, error) {
+	news, err := analyticsService.newsRepository.FindNewsByID(analyticsService.db, request.NewsID)
+	if err != nil {
+		return newsdto.NewsAnalyticsResponse{}, err
+	}
+	if news == nil {
+		notFoundError := exception.NotFoundError{Item: analyticsService.constants.Field.News}
+		return newsdto.NewsAnalyticsResponse{}, notFoundError
+	}
+
+	days := request.Days
+	if days <= 0 {
+		days = analyticsService.newsConfig.AnalyticsDays
+	}
+	since := analyticsService.startOfWindow(days)
+
+	views, err := analyticsService.newsRepository.SumNewsViews(analyticsService.db, news.ID)
+	if err != nil {
+		return newsdto.NewsAnalyticsResponse{}, err
+	}
+	likes, err := analyticsService.newsRepository.CountNewsLikes(analyticsService.db, news.ID, "news")
+	if err != nil {
+		return newsdto.NewsAnalyticsResponse{}, err
+	}
+	commentStatuses := []enum.CommentStatus{enum.CommentStatusPending, enum.CommentStatusApproved, enum.CommentStatusRejected}
+	comments, err := analyticsService.newsCommentRepository.CountNewsComments(analyticsService.db, news.ID, commentStatuses)
+	if err != nil {
+		return newsdto.NewsAnalyticsResponse{}, err
+	}
+
+	dailyViews, err := analyticsService.newsRepository.FindNewsDailyViews(analyticsService.db, news.ID, since)
+	if err != nil {
+		return newsdto.NewsAnalyticsResponse{}, err
+	}
+	dailyLikes, err := analyticsService.newsRepository.FindNewsDailyLikes(analyticsService.db, news.ID, "news", since)
+	if err != nil {
+		return newsdto.NewsAnalyticsResponse{}, err
+	}
+	dailyComments, err := analyticsService.newsCommentRepository.FindNewsDailyComments(analyticsService.db, news.ID, since)
+	if err != nil {
+		return newsdto.NewsAnalyticsResponse{}, err
+	}
+
+	daily := make([]newsdto.NewsDailyStatsResponse, days)
+	dayIndex := make(map[string]int, days)
+	for i := range daily {
+		date := since.AddDate(0, 0, i).Format(analyticsDateLayout)
+		daily[i] = newsdto.NewsDailyStatsResponse{Date: date}
+		dayIndex[date] = i
+	}
+	for _, count := range dailyViews {
+		if i, ok := dayIndex[count.Day.Format(analyticsDateLayout)]; ok {
+			daily[i].Views = count.Count
+		}
+	}
+	for _, count := range dailyLikes {
+		if i, ok := dayIndex[count.Day.Format(analyticsDateLayout)]; ok {
+			daily[i].Likes = count.Count
+		}
+	}
+	for _, count := range dailyComments {
+		if i, ok := dayIndex[count.Day.Format(analyticsDateLayout)]; ok {
+			daily[i].Comments = count.Count
+		}
+	}
+
+	return newsdto.NewsAnalyticsResponse{
+		NewsID:   news.ID,
+		Views:    views,
+		Likes:    likes,
+		Comments: comments,
+		Daily:    daily,
+	}, nil
+}
+
+func (analyticsService *NewsAnalyticsService) GetMostReadNews(locale string) ([]newsdto.PublicNewsResponse, error) {
+	since := analyticsService.startOfWindow(analyticsService.newsConfig.MostReadDays)
+	news, err := analyticsService.newsRepository.FindMostViewedNews(analyticsService.db, since, analyticsService.newsConfig.MostReadLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	newsResponse := make([]newsdto.PublicNewsResponse, len(news))
+	for i, eachNews := range news {
+		newsResponse[i], err = analyticsService.newsService.GetPublicNews(newsdto.GetPublicNewsRequest{NewsID: eachNews.ID, Locale: locale})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newsResponse, nil
+}
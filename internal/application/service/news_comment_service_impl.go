@@ -0,0 +1,386 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/metrics"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/redis"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	postgresImpl "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
+)
+
+type NewsCommentService struct {
+	constants                *bootstrap.Constants
+	newsConfig               *bootstrap.News
+	userService              usecase.UserService
+	newsRepository           postgres.NewsRepository
+	newsCommentRepository    postgres.NewsCommentRepository
+	rateLimitCacheRepository redis.RateLimitCacheRepository
+	metricsClient            metrics.MetricsClient
+	db                       database.Database
+}
+
+func NewNewsCommentService(
+	constants *bootstrap.Constants,
+	newsConfig *bootstrap.News,
+	userService usecase.UserService,
+	newsRepository postgres.NewsRepository,
+	newsCommentRepository postgres.NewsCommentRepository,
+	rateLimitCacheRepository redis.RateLimitCacheRepository,
+	metricsClient metrics.MetricsClient,
+	db database.Database,
+) *NewsCommentService {
+	return &NewsCommentService{
+		constants:                constants,
+		newsConfig:               newsConfig,
+		userService:              userService,
+		newsRepository:           newsRepository,
+		newsCommentRepository:    newsCommentRepository,
+		rateLimitCacheRepository: rateLimitCacheRepository,
+		metricsClient:            metricsClient,
+		db:                       db,
+	}
+}
+
+func (commentService *NewsCommentService) mapToFilterStatuses(enumStatus uint) []enum.CommentStatus {
+	statuses := enum.GetAllCommentStatuses()
+	for _, status := range statuses {
+		if uint(status) == enumStatus {
+			if status == enum.CommentStatusAll {
+				return statuses
+			}
+			return []enum.CommentStatus{status}
+		}
+	}
+	return statuses
+}
+
+func (commentService *NewsCommentService) GetAllCommentStatuses() []newsdto.CommentStatusesResponse {
+	allowedStatuses := []enum.CommentStatus{
+		enum.CommentStatusPending,
+		enum.CommentStatusApproved,
+		enum.CommentStatusRejected,
+	}
+
+	statuses := make([]newsdto.CommentStatusesResponse, len(allowedStatuses))
+	for i, status := range allowedStatuses {
+		statuses[i] = newsdto.CommentStatusesResponse{
+			ID:   uint(status),
+			Name: status.String(),
+		}
+	}
+	return statuses
+}
+
+func (commentService *NewsCommentService) getPublishedNews(newsID uint) (*entity.News, error) {
+	news, err := commentService.newsRepository.FindNewsByID(commentService.db, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if news == nil || news.Status != enum.NewsStatusActive {
+		notFoundError := exception.NotFoundError{Item: commentService.constants.Field.News}
+		return nil, notFoundError
+	}
+	return news, nil
+}
+
+func (commentService *NewsCommentService) getCommentByID(commentID uint) (*entity.NewsComment, error) {
+	comment, err := commentService.newsCommentRepository.FindCommentByID(commentService.db, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment == nil {
+		notFoundError := exception.NotFoundError{Item: commentService.constants.Field.Comment}
+		return nil, notFoundError
+	}
+	return comment, nil
+}
+
+// getCommentAuthors loads the authors of a page of comments and their replies at once.
+func (commentService *NewsCommentService) getCommentAuthors(comments []*entity.NewsComment) (map[uint]*entity.User, error) {
+	seen := make(map[uint]bool, len(comments))
+	userIDs := make([]uint, 0, len(comments))
+	for _, comment := range comments {
+		if !seen[comment.UserID] {
+			seen[comment.UserID] = true
+			userIDs = append(userIDs, comment.UserID)
+		}
+	}
+	return commentService.userService.GetUsersByIDs(userIDs)
+}
+
+func (commentService *NewsCommentService) mapToCommentResponse(comment *entity.NewsComment, authors map[uint]*entity.User) (newsdto.NewsCommentResponse, error) {
+	author, ok := authors[comment.UserID]
+	if !ok {
+		notFoundError := exception.NotFoundError{Item: commentService.constants.Field.User}
+		return newsdto.NewsCommentResponse{}, notFoundError
+	}
+
+	return newsdto.NewsCommentResponse{
+		ID:        comment.ID,
+		Author:    strings.TrimSpace(author.FirstName + " " + author.LastName),
+		Content:   comment.Content,
+		CreatedAt: comment.CreatedAt,
+	}, nil
+}
+
+func (commentService *NewsCommentService) GetNewsComments(request newsdto.GetNewsCommentsRequest) ([]newsdto.NewsCommentResponse, error) {
+	if _, err := commentService.getPublishedNews(request.NewsID); err != nil {
+		return nil, err
+	}
+
+	paginationModifier := postgresImpl.NewPaginationModifier(request.Limit, request.Offset)
+	sortingModifier := postgresImpl.NewSortingModifier("created_at", true)
+
+	approved := []enum.CommentStatus{enum.CommentStatusApproved}
+	comments, err := commentService.newsCommentRepository.FindNewsRootComments(commentService.db, request.NewsID, approved, paginationModifier, sortingModifier)
+	if err != nil {
+		return nil, err
+	}
+	if len(comments) == 0 {
+		return []newsdto.NewsCommentResponse{}, nil
+	}
+
+	commentIDs := make([]uint, len(comments))
+	for i, comment := range comments {
+		commentIDs[i] = comment.ID
+	}
+	replies, err := commentService.newsCommentRepository.FindCommentReplies(commentService.db, commentIDs, approved)
+	if err != nil {
+		return nil, err
+	}
+	authors, err := commentService.getCommentAuthors(append(comments, replies...))
+	if err != nil {
+		return nil, err
+	}
+
+	repliesByParent := make(map[uint][]newsdto.NewsCommentResponse)
+	for _, reply := range replies {
+		replyResponse, err := commentService.mapToCommentResponse(reply, authors)
+		if err != nil {
+			return nil, err
+		}
+		repliesByParent[*reply.ParentID] = append(repliesByParent[*reply.ParentID], replyResponse)
+	}
+
+	commentsResponse := make([]newsdto.NewsCommentResponse, len(comments))
+	for i, comment := range comments {
+		commentsResponse[i], err = commentService.mapToCommentResponse(comment, authors)
+		if err != nil {
+			return nil, err
+		}
+		commentsResponse[i].Replies = repliesByParent[comment.ID]
+	}
+	return commentsResponse, nil
+}
+
+// isTrustedCommenter reports whether the user has enough approved comments for
+// new ones to skip the moderation queue.
+func (commentService *NewsCommentService) isTrustedCommenter(userID uint) (bool, error) {
+	approved, err := commentService.newsCommentRepository.CountUserComments(commentService.db, userID, []enum.CommentStatus{enum.CommentStatusApproved})
+	if err != nil {
+		return false, err
+	}
+	return approved >= int64(commentService.newsConfig.TrustedCommenterApproved), nil
+}
+
+func (commentService *NewsCommentService) CreateComment(request newsdto.CreateNewsCommentRequest) (newsdto.CreateNewsCommentResponse, error) {
+	if err := commentService.userService.IsUserActive(request.UserID); err != nil {
+		return newsdto.CreateNewsCommentResponse{}, err
+	}
+
+	limit := commentService.newsConfig.CommentLimit
+	window := time.Duration(commentService.newsConfig.CommentWindowMinutes) * time.Minute
+	redisKey := commentService.constants.RedisKey.GenerateCommentRateLimitKey(request.UserID)
+	attempts, err := commentService.rateLimitCacheRepository.Increment(context.Background(), redisKey, window)
+	if err != nil {
+		return newsdto.CreateNewsCommentResponse{}, err
+	}
+	if attempts > int64(limit) {
+		return newsdto.CreateNewsCommentResponse{}, exception.NewRequestRateLimitError("", limit, nil)
+	}
+
+	if _, err := commentService.getPublishedNews(request.NewsID); err != nil {
+		return newsdto.CreateNewsCommentResponse{}, err
+	}
+
+	if request.ParentID != nil {
+		parent, err := commentService.getCommentByID(*request.ParentID)
+		if err != nil {
+			return newsdto.CreateNewsCommentResponse{}, err
+		}
+		if parent.NewsID != request.NewsID || parent.ParentID != nil || parent.Status != enum.CommentStatusApproved {
+			var validationErrors exception.ValidationErrors
+			validationErrors.Add(commentService.constants.Field.Comment, commentService.constants.Tag.InvalidReply)
+			return newsdto.CreateNewsCommentResponse{}, validationErrors
+		}
+	}
+
+	trusted, err := commentService.isTrustedCommenter(request.UserID)
+	if err != nil {
+		return newsdto.CreateNewsCommentResponse{}, err
+	}
+	status := enum.CommentStatusPending
+	if trusted {
+		status = enum.CommentStatusApproved
+	}
+
+	comment := &entity.NewsComment{
+		NewsID:   request.NewsID,
+		UserID:   request.UserID,
+		ParentID: request.ParentID,
+		Content:  strings.TrimSpace(request.Content),
+		Status:   status,
+	}
+	if err := commentService.newsCommentRepository.CreateComment(commentService.db, comment); err != nil {
+		return newsdto.CreateNewsCommentResponse{}, err
+	}
+	commentService.metricsClient.IncBusinessEvent("news", "comment")
+
+	return newsdto.CreateNewsCommentResponse{
+		ID:     comment.ID,
+		Status: comment.Status.String(),
+	}, nil
+}
+
+// FlagComment records a report against a comment. Once enough distinct users
+// have reported an approved comment it is pulled back into the moderation queue.
+func (commentService *NewsCommentService) FlagComment(request newsdto.FlagNewsCommentRequest) error {
+	if err := commentService.userService.IsUserActive(request.UserID); err != nil {
+		return err
+	}
+
+	comment, err := commentService.getCommentByID(request.CommentID)
+	if err != nil {
+		return err
+	}
+	if comment.Status != enum.CommentStatusApproved {
+		notFoundError := exception.NotFoundError{Item: commentService.constants.Field.Comment}
+		return notFoundError
+	}
+
+	flag, err := commentService.newsCommentRepository.FindCommentFlag(commentService.db, comment.ID, request.UserID)
+	if err != nil {
+		return err
+	}
+	if flag != nil {
+		var conflictErrors exception.ConflictErrors
+		conflictErrors.Add(commentService.constants.Field.Comment, commentService.constants.Tag.AlreadyFlagged)
+		return conflictErrors
+	}
+
+	flag = &entity.NewsCommentFlag{
+		CommentID: comment.ID,
+		UserID:    request.UserID,
+		Reason:    request.Reason,
+	}
+	err = commentService.db.WithTransaction(func(tx database.Database) error {
+		if err := commentService.newsCommentRepository.CreateCommentFlag(tx, flag); err != nil {
+			return err
+		}
+		flagCount, err := commentService.newsCommentRepository.IncrementCommentFlagCount(tx, comment.ID)
+		if err != nil {
+			return err
+		}
+		if flagCount < uint(commentService.newsConfig.CommentFlagThreshold) {
+			return nil
+		}
+		return commentService.newsCommentRepository.UpdateCommentStatus(tx, comment.ID, enum.CommentStatusPending)
+	})
+	if err != nil {
+		return err
+	}
+
+	commentService.metricsClient.IncBusinessEvent("news", "comment_flag")
+	return nil
+}
+
+func (commentService *NewsCommentService) GetCommentQueue(request newsdto.GetCommentQueueRequest) ([]newsdto.AdminNewsCommentResponse, error) {
+	paginationModifier := postgresImpl.NewPaginationModifier(request.Limit, request.Offset)
+	sortingModifier := postgresImpl.NewSortingModifier("created_at", false)
+
+	allowedStatuses := commentService.mapToFilterStatuses(request.Status)
+	comments, err := commentService.newsCommentRepository.FindCommentsByStatus(commentService.db, allowedStatuses, paginationModifier, sortingModifier)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint, len(comments))
+	for i, comment := range comments {
+		userIDs[i] = comment.UserID
+	}
+	authors, err := commentService.userService.GetUserCredentials(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	commentsResponse := make([]newsdto.AdminNewsCommentResponse, len(comments))
+	for i, comment := range comments {
+		commentsResponse[i] = newsdto.AdminNewsCommentResponse{
+			ID:        comment.ID,
+			NewsID:    comment.NewsID,
+			ParentID:  comment.ParentID,
+			Author:    authors[comment.UserID],
+			Content:   comment.Content,
+			Status:    comment.Status.String(),
+			FlagCount: comment.FlagCount,
+			CreatedAt: comment.CreatedAt,
+		}
+	}
+	return commentsResponse, nil
+}
+
+func (commentService *NewsCommentService) ReviewComment(request newsdto.ReviewNewsCommentRequest) error {
+	if err := commentService.userService.IsUserActive(request.ReviewerID); err != nil {
+		return err
+	}
+
+	comment, err := commentService.getCommentByID(request.CommentID)
+	if err != nil {
+		return err
+	}
+
+	var conflictErrors exception.ConflictErrors
+	switch enum.ReviewAction(request.Action) {
+	case enum.ReviewActionApproved:
+		if comment.Status == enum.CommentStatusApproved {
+			conflictErrors.Add(commentService.constants.Field.Comment, commentService.constants.Tag.AlreadyAccepted)
+			return conflictErrors
+		}
+		comment.Status = enum.CommentStatusApproved
+	case enum.ReviewActionRejected:
+		if comment.Status == enum.CommentStatusRejected {
+			conflictErrors.Add(commentService.constants.Field.Comment, commentService.constants.Tag.AlreadyRejected)
+			return conflictErrors
+		}
+		comment.Status = enum.CommentStatusRejected
+	case enum.ReviewActionSuspended:
+		if comment.Status == enum.CommentStatusPending {
+			conflictErrors.Add(commentService.constants.Field.Comment, commentService.constants.Tag.Pending)
+			return conflictErrors
+		}
+		comment.Status = enum.CommentStatusPending
+	}
+
+	if comment.Status != enum.CommentStatusApproved {
+		return commentService.newsCommentRepository.UpdateCommentStatus(commentService.db, comment.ID, comment.Status)
+	}
+	return commentService.db.WithTransaction(func(tx database.Database) error {
+		if err := commentService.newsCommentRepository.DeleteCommentFlags(tx, comment.ID); err != nil {
+			return err
+		}
+		if err := commentService.newsCommentRepository.ResetCommentFlagCount(tx, comment.ID); err != nil {
+			return err
+		}
+		return commentService.newsCommentRepository.UpdateCommentStatus(tx, comment.ID, comment.Status)
+	})
+}
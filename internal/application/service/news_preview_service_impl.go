@@ -0,0 +1,208 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+	userdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/user"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type NewsPreviewService struct {
+	constants      *bootstrap.Constants
+	newsConfig     *bootstrap.News
+	userService    usecase.UserService
+	newsRepository postgres.NewsRepository
+	db             database.Database
+}
+
+func NewNewsPreviewService(
+	constants *bootstrap.Constants,
+	newsConfig *bootstrap.News,
+	userService usecase.UserService,
+	newsRepository postgres.NewsRepository,
+	db database.Database,
+) *NewsPreviewService {
+	return &NewsPreviewService{
+		constants:      constants,
+		newsConfig:     newsConfig,
+		userService:    userService,
+		newsRepository: newsRepository,
+		db:             db,
+	}
+}
+
+func (previewService *NewsPreviewService) getNewsByID(newsID uint) (*entity.News, error) {
+	news, err := previewService.newsRepository.FindNewsByID(previewService.db, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if news == nil {
+		notFoundError := exception.NotFoundError{Item: previewService.constants.Field.News}
+		return nil, notFoundError
+	}
+	return news, nil
+}
+
+var errPreviewSigningKeyNotSet = fmt.Errorf("news preview signing key is not set")
+
+// signPreviewToken builds "<id>.<nonce>.<expiry>.<signature>". The row holds the
+// nonce and revocation state, the signature keeps token IDs from being guessed.
+func (previewService *NewsPreviewService) signPreviewToken(previewToken *entity.NewsPreviewToken) (string, error) {
+	if previewService.newsConfig.PreviewSigningKey == "" {
+		return "", errPreviewSigningKeyNotSet
+	}
+	payload := fmt.Sprintf("%d.%s.%d", previewToken.ID, previewToken.Nonce, previewToken.ExpiresAt.Unix())
+	mac := hmac.New(sha256.New, []byte(previewService.newsConfig.PreviewSigningKey))
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+func (previewService *NewsPreviewService) IsValidPreviewToken(newsID uint, token string) (bool, error) {
+	if previewService.newsConfig.PreviewSigningKey == "" {
+		return false, nil
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return false, nil
+	}
+	previewTokenID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return false, nil
+	}
+
+	previewToken, err := previewService.newsRepository.FindNewsPreviewTokenByID(previewService.db, uint(previewTokenID))
+	if err != nil {
+		return false, err
+	}
+	if previewToken == nil || previewToken.NewsID != newsID || previewToken.RevokedAt != nil || !previewToken.ExpiresAt.After(time.Now()) {
+		return false, nil
+	}
+	expected, err := previewService.signPreviewToken(previewToken)
+	if err != nil {
+		return false, err
+	}
+	return hmac.Equal([]byte(expected), []byte(token)), nil
+}
+
+func (previewService *NewsPreviewService) mapToNewsPreviewResponse(previewToken *entity.NewsPreviewToken, createdBy userdto.CredentialResponse) (newsdto.NewsPreviewResponse, error) {
+	token, err := previewService.signPreviewToken(previewToken)
+	if err != nil {
+		return newsdto.NewsPreviewResponse{}, err
+	}
+
+	return newsdto.NewsPreviewResponse{
+		ID:        previewToken.ID,
+		Token:     token,
+		CreatedBy: createdBy,
+		ExpiresAt: previewToken.ExpiresAt,
+		CreatedAt: previewToken.CreatedAt,
+	}, nil
+}
+
+func (previewService *NewsPreviewService) CreateNewsPreview(request newsdto.CreateNewsPreviewRequest) (newsdto.NewsPreviewResponse, error) {
+	if err := previewService.userService.IsUserActive(request.AuthorID); err != nil {
+		return newsdto.NewsPreviewResponse{}, err
+	}
+
+	if previewService.newsConfig.PreviewSigningKey == "" {
+		return newsdto.NewsPreviewResponse{}, errPreviewSigningKeyNotSet
+	}
+
+	news, err := previewService.getNewsByID(request.NewsID)
+	if err != nil {
+		return newsdto.NewsPreviewResponse{}, err
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return newsdto.NewsPreviewResponse{}, err
+	}
+
+	expiresInHours := request.ExpiresInHours
+	if expiresInHours <= 0 {
+		expiresInHours = previewService.newsConfig.PreviewTokenHours
+	}
+	if expiresInHours > previewService.newsConfig.PreviewTokenMaxHours {
+		var validationErrors exception.ValidationErrors
+		validationErrors.Add(previewService.constants.Field.ExpiresInHours, previewService.constants.Tag.ExceedsMaximum)
+		return newsdto.NewsPreviewResponse{}, validationErrors
+	}
+	previewToken := &entity.NewsPreviewToken{
+		NewsID:      news.ID,
+		CreatedByID: request.AuthorID,
+		Nonce:       hex.EncodeToString(nonce),
+		ExpiresAt:   time.Now().Add(time.Duration(expiresInHours) * time.Hour).Truncate(time.Second),
+	}
+	if err := previewService.newsRepository.CreateNewsPreviewToken(previewService.db, previewToken); err != nil {
+		return newsdto.NewsPreviewResponse{}, err
+	}
+
+	createdBy, err := previewService.userService.GetUserCredential(request.AuthorID)
+	if err != nil {
+		return newsdto.NewsPreviewResponse{}, err
+	}
+	return previewService.mapToNewsPreviewResponse(previewToken, createdBy)
+}
+
+func (previewService *NewsPreviewService) GetNewsPreviews(newsID uint) ([]newsdto.NewsPreviewResponse, error) {
+	if _, err := previewService.getNewsByID(newsID); err != nil {
+		return nil, err
+	}
+
+	previewTokens, err := previewService.newsRepository.FindActiveNewsPreviewTokens(previewService.db, newsID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	creatorIDs := make([]uint, len(previewTokens))
+	for i, previewToken := range previewTokens {
+		creatorIDs[i] = previewToken.CreatedByID
+	}
+	creators, err := previewService.userService.GetUserCredentials(creatorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	previewsResponse := make([]newsdto.NewsPreviewResponse, len(previewTokens))
+	for i, previewToken := range previewTokens {
+		previewsResponse[i], err = previewService.mapToNewsPreviewResponse(previewToken, creators[previewToken.CreatedByID])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return previewsResponse, nil
+}
+
+func (previewService *NewsPreviewService) RevokeNewsPreview(request newsdto.RevokeNewsPreviewRequest) error {
+	if err := previewService.userService.IsUserActive(request.AuthorID); err != nil {
+		return err
+	}
+
+	previewToken, err := previewService.newsRepository.FindNewsPreviewTokenByID(previewService.db, request.PreviewID)
+	if err != nil {
+		return err
+	}
+	if previewToken == nil || previewToken.NewsID != request.NewsID || previewToken.RevokedAt != nil {
+		notFoundError := exception.NotFoundError{Item: previewService.constants.Field.NewsPreview}
+		return notFoundError
+	}
+
+	now := time.Now()
+	previewToken.RevokedAt = &now
+	return previewService.newsRepository.UpdateNewsPreviewToken(previewService.db, previewToken)
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"github.com/stretchr/testify/assert"
+)
+
+// previewTokenRepository serves preview tokens from memory; any other
+// repository call panics on the nil embedded interface.
+type previewTokenRepository struct {
+	postgres.NewsRepository
+	previewTokens map[uint]*entity.NewsPreviewToken
+}
+
+func (repo *previewTokenRepository) FindNewsPreviewTokenByID(db database.Database, previewTokenID uint) (*entity.NewsPreviewToken, error) {
+	return repo.previewTokens[previewTokenID], nil
+}
+
+func TestNewsPreviewService_IsValidPreviewToken(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Minute)
+	newPreviewToken := func(id uint, expiresAt time.Time, revokedAt *time.Time) *entity.NewsPreviewToken {
+		return &entity.NewsPreviewToken{
+			Model:     database.Model{ID: id},
+			NewsID:    7,
+			Nonce:     "nonce",
+			ExpiresAt: expiresAt,
+			RevokedAt: revokedAt,
+		}
+	}
+	active := newPreviewToken(1, now.Add(time.Hour), nil)
+	expired := newPreviewToken(2, now.Add(-time.Hour), nil)
+	revoked := newPreviewToken(3, now.Add(time.Hour), &revokedAt)
+
+	previewService := &NewsPreviewService{
+		newsConfig: &bootstrap.News{PreviewSigningKey: "secret"},
+		newsRepository: &previewTokenRepository{
+			previewTokens: map[uint]*entity.NewsPreviewToken{1: active, 2: expired, 3: revoked},
+		},
+	}
+	otherKeyService := &NewsPreviewService{newsConfig: &bootstrap.News{PreviewSigningKey: "other"}}
+	sign := func(service *NewsPreviewService, previewToken *entity.NewsPreviewToken) string {
+		token, err := service.signPreviewToken(previewToken)
+		assert.NoError(t, err)
+		return token
+	}
+	token := sign(previewService, active)
+
+	tests := []struct {
+		name     string
+		newsID   uint
+		token    string
+		expected bool
+	}{
+		{name: "valid token", newsID: 7, token: token, expected: true},
+		{name: "other news", newsID: 8, token: token, expected: false},
+		{name: "expired token", newsID: 7, token: sign(previewService, expired), expected: false},
+		{name: "revoked token", newsID: 7, token: sign(previewService, revoked), expected: false},
+		{name: "unknown token", newsID: 7, token: "9.nonce.1.signature", expected: false},
+		{name: "tampered nonce", newsID: 7, token: strings.Replace(token, ".nonce.", ".other.", 1), expected: false},
+		{name: "signed with another key", newsID: 7, token: sign(otherKeyService, active), expected: false},
+		{name: "malformed token", newsID: 7, token: "not-a-token", expected: false},
+		{name: "non numeric id", newsID: 7, token: "a.b.c.d", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			valid, err := previewService.IsValidPreviewToken(test.newsID, test.token)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, valid)
+		})
+	}
+}
+
+func TestNewsPreviewService_PreviewTokenWithoutSigningKey(t *testing.T) {
+	previewToken := &entity.NewsPreviewToken{
+		Model:     database.Model{ID: 1},
+		NewsID:    7,
+		Nonce:     "nonce",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	previewService := &NewsPreviewService{
+		newsConfig: &bootstrap.News{},
+		newsRepository: &previewTokenRepository{
+			previewTokens: map[uint]*entity.NewsPreviewToken{1: previewToken},
+		},
+	}
+
+	_, err := previewService.signPreviewToken(previewToken)
+	assert.Error(t, err)
+
+	// A token signed with the empty key must not verify either.
+	payload := fmt.Sprintf("1.nonce.%d", previewToken.ExpiresAt.Unix())
+	mac := hmac.New(sha256.New, nil)
+	mac.Write([]byte(payload))
+	token := payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	valid, err := previewService.IsValidPreviewToken(7, token)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
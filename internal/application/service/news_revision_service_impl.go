@@ -0,0 +1,181 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	postgresImpl "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
+)
+
+type NewsRevisionService struct {
+	constants      *bootstrap.Constants
+	userService    usecase.UserService
+	newsRepository postgres.NewsRepository
+	db             database.Database
+}
+
+func NewNewsRevisionService(
+	constants *bootstrap.Constants,
+	userService usecase.UserService,
+	newsRepository postgres.NewsRepository,
+	db database.Database,
+) *NewsRevisionService {
+	return &NewsRevisionService{
+		constants:      constants,
+		userService:    userService,
+		newsRepository: newsRepository,
+		db:             db,
+	}
+}
+
+func (revisionService *NewsRevisionService) getNewsByID(newsID uint) (*entity.News, error) {
+	news, err := revisionService.newsRepository.FindNewsByID(revisionService.db, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if news == nil {
+		notFoundError := exception.NotFoundError{Item: revisionService.constants.Field.News}
+		return nil, notFoundError
+	}
+	return news, nil
+}
+
+func (revisionService *NewsRevisionService) GetNewsRevision(revisionID, newsID uint) (*entity.NewsRevision, error) {
+	revision, err := revisionService.newsRepository.FindNewsRevisionByID(revisionService.db, revisionID, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if revision == nil {
+		notFoundError := exception.NotFoundError{Item: revisionService.constants.Field.Revision}
+		return nil, notFoundError
+	}
+	return revision, nil
+}
+
+// mapToNewsRevisionResponses loads the editors of all revisions in one query.
+func (revisionService *NewsRevisionService) mapToNewsRevisionResponses(revisions []*entity.NewsRevision) ([]newsdto.NewsRevisionResponse, error) {
+	editorIDs := make([]uint, len(revisions))
+	for i, revision := range revisions {
+		editorIDs[i] = revision.EditorID
+	}
+	editors, err := revisionService.userService.GetUserCredentials(editorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionsResponse := make([]newsdto.NewsRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		revisionsResponse[i] = newsdto.NewsRevisionResponse{
+			ID:           revision.ID,
+			Number:       revision.Number,
+			Editor:       editors[revision.EditorID],
+			Title:        revision.Title,
+			RestoredFrom: revision.RestoredFrom,
+			CreatedAt:    revision.CreatedAt,
+		}
+	}
+	return revisionsResponse, nil
+}
+
+func (revisionService *NewsRevisionService) GetNewsRevisions(request newsdto.GetNewsRevisionsRequest) ([]newsdto.NewsRevisionResponse, error) {
+	if _, err := revisionService.getNewsByID(request.NewsID); err != nil {
+		return nil, err
+	}
+
+	paginationModifier := postgresImpl.NewPaginationModifier(request.Limit, request.Offset)
+	sortingModifier := postgresImpl.NewSortingModifier("number", true)
+
+	revisions, err := revisionService.newsRepository.FindNewsRevisions(revisionService.db, request.NewsID, paginationModifier, sortingModifier)
+	if err != nil {
+		return nil, err
+	}
+	return revisionService.mapToNewsRevisionResponses(revisions)
+}
+
+func (revisionService *NewsRevisionService) CompareNewsRevisions(request newsdto.CompareNewsRevisionsRequest) (newsdto.NewsRevisionDiffResponse, error) {
+	from, err := revisionService.GetNewsRevision(request.FromRevisionID, request.NewsID)
+	if err != nil {
+		return newsdto.NewsRevisionDiffResponse{}, err
+	}
+	to, err := revisionService.GetNewsRevision(request.ToRevisionID, request.NewsID)
+	if err != nil {
+		return newsdto.NewsRevisionDiffResponse{}, err
+	}
+
+	revisionsResponse, err := revisionService.mapToNewsRevisionResponses([]*entity.NewsRevision{from, to})
+	if err != nil {
+		return newsdto.NewsRevisionDiffResponse{}, err
+	}
+
+	return newsdto.NewsRevisionDiffResponse{
+		From:        revisionsResponse[0],
+		To:          revisionsResponse[1],
+		Title:       diffLines(from.Title, to.Title),
+		Description: diffLines(from.Description, to.Description),
+		Content:     diffLines(from.Content, to.Content),
+	}, nil
+}
+
+const maxDiffCells = 4_000_000
+
+// diffLines returns a line based diff of two texts using the longest common
+// subsequence. Texts too large for the quadratic table are reported as a full
+// replacement.
+func diffLines(from, to string) []newsdto.DiffLineResponse {
+	fromLines := strings.Split(from, "\n")
+	toLines := strings.Split(to, "\n")
+	diff := []newsdto.DiffLineResponse{}
+
+	if len(fromLines)*len(toLines) > maxDiffCells {
+		for _, line := range fromLines {
+			diff = append(diff, newsdto.DiffLineResponse{Operation: "delete", Text: line})
+		}
+		for _, line := range toLines {
+			diff = append(diff, newsdto.DiffLineResponse{Operation: "insert", Text: line})
+		}
+		return diff
+	}
+
+	lcs := make([][]int, len(fromLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(toLines)+1)
+	}
+	for i := len(fromLines) - 1; i >= 0; i-- {
+		for j := len(toLines) - 1; j >= 0; j-- {
+			if fromLines[i] == toLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(fromLines) && j < len(toLines) {
+		switch {
+		case fromLines[i] == toLines[j]:
+			diff = append(diff, newsdto.DiffLineResponse{Operation: "equal", Text: fromLines[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, newsdto.DiffLineResponse{Operation: "delete", Text: fromLines[i]})
+			i++
+		default:
+			diff = append(diff, newsdto.DiffLineResponse{Operation: "insert", Text: toLines[j]})
+			j++
+		}
+	}
+	for ; i < len(fromLines); i++ {
+		diff = append(diff, newsdto.DiffLineResponse{Operation: "delete", Text: fromLines[i]})
+	}
+	for ; j < len(toLines); j++ {
+		diff = append(diff, newsdto.DiffLineResponse{Operation: "insert", Text: toLines[j]})
+	}
+	return diff
+}
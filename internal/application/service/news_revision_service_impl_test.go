@@ -0,0 +1,85 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected []newsdto.DiffLineResponse
+	}{
+		{
+			name: "identical texts",
+			from: "a\nb",
+			to:   "a\nb",
+			expected: []newsdto.DiffLineResponse{
+				{Operation: "equal", Text: "a"},
+				{Operation: "equal", Text: "b"},
+			},
+		},
+		{
+			name: "inserted line",
+			from: "a\nc",
+			to:   "a\nb\nc",
+			expected: []newsdto.DiffLineResponse{
+				{Operation: "equal", Text: "a"},
+				{Operation: "insert", Text: "b"},
+				{Operation: "equal", Text: "c"},
+			},
+		},
+		{
+			name: "deleted line",
+			from: "a\nb\nc",
+			to:   "a\nc",
+			expected: []newsdto.DiffLineResponse{
+				{Operation: "equal", Text: "a"},
+				{Operation: "delete", Text: "b"},
+				{Operation: "equal", Text: "c"},
+			},
+		},
+		{
+			name: "replaced line",
+			from: "a\nb\nc",
+			to:   "a\nx\nc",
+			expected: []newsdto.DiffLineResponse{
+				{Operation: "equal", Text: "a"},
+				{Operation: "delete", Text: "b"},
+				{Operation: "insert", Text: "x"},
+				{Operation: "equal", Text: "c"},
+			},
+		},
+		{
+			name: "trailing lines",
+			from: "a",
+			to:   "a\nb\nc",
+			expected: []newsdto.DiffLineResponse{
+				{Operation: "equal", Text: "a"},
+				{Operation: "insert", Text: "b"},
+				{Operation: "insert", Text: "c"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, diffLines(test.from, test.to))
+		})
+	}
+}
+
+func TestDiffLines_TooLarge(t *testing.T) {
+	from := strings.Repeat("a\n", 2001)
+	to := strings.Repeat("b\n", 2001)
+
+	diff := diffLines(from, to)
+	assert.Len(t, diff, 2*2002)
+	assert.Equal(t, "delete", diff[0].Operation)
+	assert.Equal(t, "insert", diff[len(diff)-1].Operation)
+}
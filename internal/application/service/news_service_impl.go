@@ -1,7 +1,14 @@
 package service
 
 import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/CosmeticsShiraz/Backend/bootstrap"
 	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
@@ -10,32 +17,64 @@ import (
 	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/redis"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/s3"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/sanitizer"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
 	postgresImpl "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
 )
 
 type NewsService struct {
-	constants      *bootstrap.Constants
-	userService    usecase.UserService
-	s3Storage      s3.S3Storage
-	newsRepository postgres.NewsRepository
-	db             database.Database
+	constants               *bootstrap.Constants
+	newsConfig              *bootstrap.News
+	userService             usecase.UserService
+	s3Storage               s3.S3Storage
+	imageService            usecase.ImageService
+	uploadService           usecase.UploadService
+	revisionService         usecase.NewsRevisionService
+	previewService          usecase.NewsPreviewService
+	htmlSanitizer           sanitizer.HTMLSanitizer
+	feedService             usecase.FeedService
+	newsRepository          postgres.NewsRepository
+	pendingUploadRepository postgres.PendingUploadRepository
+	newsCommentRepository   postgres.NewsCommentRepository
+	lockCacheRepository     redis.LockCacheRepository
+	db                      database.Database
 }
 
 func NewNewsService(
 	constants *bootstrap.Constants,
+	newsConfig *bootstrap.News,
 	userService usecase.UserService,
 	s3Storage s3.S3Storage,
+	imageService usecase.ImageService,
+	uploadService usecase.UploadService,
+	revisionService usecase.NewsRevisionService,
+	previewService usecase.NewsPreviewService,
+	htmlSanitizer sanitizer.HTMLSanitizer,
+	feedService usecase.FeedService,
 	newsRepository postgres.NewsRepository,
+	pendingUploadRepository postgres.PendingUploadRepository,
+	newsCommentRepository postgres.NewsCommentRepository,
+	lockCacheRepository redis.LockCacheRepository,
 	db database.Database,
 ) *NewsService {
 	return &NewsService{
-		constants:      constants,
-		userService:    userService,
-		s3Storage:      s3Storage,
-		newsRepository: newsRepository,
-		db:             db,
+		constants:               constants,
+		newsConfig:              newsConfig,
+		userService:             userService,
+		s3Storage:               s3Storage,
+		imageService:            imageService,
+		uploadService:           uploadService,
+		revisionService:         revisionService,
+		previewService:          previewService,
+		htmlSanitizer:           htmlSanitizer,
+		feedService:             feedService,
+		newsRepository:          newsRepository,
+		pendingUploadRepository: pendingUploadRepository,
+		newsCommentRepository:   newsCommentRepository,
+		lockCacheRepository:     lockCacheRepository,
+		db:                      db,
 	}
 }
 
@@ -66,6 +105,7 @@ func (newsService *NewsService) GetAllNewsStatuses() []newsdto.NewsStatusesRespo
 	allowedStatuses := []enum.NewsStatus{
 		enum.NewsStatusActive,
 		enum.NewsStatusDraft,
+		enum.NewsStatusScheduled,
 	}
 
 	statuses := make([]newsdto.NewsStatusesResponse, len(allowedStatuses))
@@ -108,12 +148,9 @@ func (newsService *NewsService) GetAdminNews(newsID uint) (newsdto.AdminNewsResp
 		return newsdto.AdminNewsResponse{}, err
 	}
 
-	coverImage := ""
-	if news.CoverImage != "" {
-		coverImage, err = newsService.s3Storage.GetPresignedURL(enum.NewsMedia, news.CoverImage, 8*time.Hour)
-		if err != nil {
-			return newsdto.AdminNewsResponse{}, err
-		}
+	coverImage, err := newsService.imageService.GetImageURLs(enum.NewsMedia, news.CoverImage, 8*time.Hour)
+	if err != nil {
+		return newsdto.AdminNewsResponse{}, err
 	}
 
 	author, err := newsService.userService.GetUserCredential(news.AuthorID)
@@ -121,45 +158,188 @@ func (newsService *NewsService) GetAdminNews(newsID uint) (newsdto.AdminNewsResp
 		return newsdto.AdminNewsResponse{}, err
 	}
 
+	seo, err := newsService.getNewsSEO(news)
+	if err != nil {
+		return newsdto.AdminNewsResponse{}, err
+	}
+
+	categories, tags, err := newsService.getNewsTaxonomy(news.ID)
+	if err != nil {
+		return newsdto.AdminNewsResponse{}, err
+	}
+
+	content, err := newsService.renderNewsContent(news)
+	if err != nil {
+		return newsdto.AdminNewsResponse{}, err
+	}
+
 	return newsdto.AdminNewsResponse{
 		ID:          news.ID,
 		Title:       news.Title,
-		Content:     news.Content,
+		Slug:        news.Slug,
+		Content:     content,
 		Description: news.Description,
 		Status:      news.Status.String(),
 		CoverImage:  coverImage,
 		Author:      author,
+		PublishAt:   news.PublishAt,
+		UnpublishAt: news.UnpublishAt,
+		SEO:         seo,
+		Categories:  categories,
+		Tags:        tags,
 	}, nil
 }
 
-func (newsService *NewsService) GetPublicNews(newsID uint) (newsdto.PublicNewsResponse, error) {
-	news, err := newsService.getNewsByID(newsID)
+// GetPublicNews serves published news to everyone, and unpublished news to
+// holders of a valid preview token.
+func (newsService *NewsService) GetPublicNews(request newsdto.GetPublicNewsRequest) (newsdto.PublicNewsResponse, error) {
+	news, err := newsService.getNewsByID(request.NewsID)
 	if err != nil {
 		return newsdto.PublicNewsResponse{}, err
 	}
 
-	if news.Status != enum.NewsStatusActive {
-		notFoundError := exception.NotFoundError{Item: newsService.constants.Field.News}
-		return newsdto.PublicNewsResponse{}, notFoundError
+	if news.Status != enum.NewsStatusActive && request.PreviewToken != "" {
+		validPreview, err := newsService.previewService.IsValidPreviewToken(news.ID, request.PreviewToken)
+		if err != nil {
+			return newsdto.PublicNewsResponse{}, err
+		}
+		if validPreview {
+			return newsService.mapToPublicNewsResponse(news, request.Locale)
+		}
 	}
 
-	coverImage := ""
-	if news.CoverImage != "" {
-		coverImage, err = newsService.s3Storage.GetPresignedURL(enum.NewsMedia, news.CoverImage, 8*time.Hour)
+	return newsService.getPublicNewsResponse(news, request.Locale)
+}
+
+// GetPublicNewsBySlug resolves both current slugs and slugs a news had before
+// its title changed; callers compare the returned slug to detect the latter.
+func (newsService *NewsService) GetPublicNewsBySlug(request newsdto.GetPublicNewsBySlugRequest) (newsdto.PublicNewsResponse, error) {
+	news, err := newsService.newsRepository.FindNewsBySlug(newsService.db, request.Slug)
+	if err != nil {
+		return newsdto.PublicNewsResponse{}, err
+	}
+	if news == nil {
+		redirect, err := newsService.newsRepository.FindNewsSlugRedirect(newsService.db, request.Slug)
+		if err != nil {
+			return newsdto.PublicNewsResponse{}, err
+		}
+		if redirect == nil {
+			notFoundError := exception.NotFoundError{Item: newsService.constants.Field.News}
+			return newsdto.PublicNewsResponse{}, notFoundError
+		}
+		news, err = newsService.getNewsByID(redirect.NewsID)
 		if err != nil {
 			return newsdto.PublicNewsResponse{}, err
 		}
 	}
 
+	return newsService.getPublicNewsResponse(news, request.Locale)
+}
+
+func (newsService *NewsService) getPublicNewsResponse(news *entity.News, locale string) (newsdto.PublicNewsResponse, error) {
+	if news.Status != enum.NewsStatusActive {
+		notFoundError := exception.NotFoundError{Item: newsService.constants.Field.News}
+		return newsdto.PublicNewsResponse{}, notFoundError
+	}
+
+	return newsService.mapToPublicNewsResponse(news, locale)
+}
+
+// translateNews returns the news with its text replaced by the translation for
+// locale. News without such a translation is served in Persian.
+func (newsService *NewsService) translateNews(news *entity.News, locale string) (*entity.News, string, error) {
+	if !slices.Contains(newsService.constants.Locales.GetTranslationLocales(), locale) {
+		return news, newsService.constants.Locales.Persian, nil
+	}
+	translation, err := newsService.newsRepository.FindNewsTranslation(newsService.db, news.ID, locale)
+	if err != nil {
+		return nil, "", err
+	}
+	if translation == nil {
+		return news, newsService.constants.Locales.Persian, nil
+	}
+
+	translatedNews := *news
+	translatedNews.Title = translation.Title
+	translatedNews.Description = translation.Description
+	translatedNews.Content = translation.Content
+	translatedNews.MetaTitle = ""
+	translatedNews.MetaDescription = ""
+	return &translatedNews, locale, nil
+}
+
+func (newsService *NewsService) mapToPublicNewsResponse(news *entity.News, locale string) (newsdto.PublicNewsResponse, error) {
+	news, locale, err := newsService.translateNews(news, locale)
+	if err != nil {
+		return newsdto.PublicNewsResponse{}, err
+	}
+
+	coverImage, err := newsService.imageService.GetImageURLs(enum.NewsMedia, news.CoverImage, 8*time.Hour)
+	if err != nil {
+		return newsdto.PublicNewsResponse{}, err
+	}
+
+	seo, err := newsService.getNewsSEO(news)
+	if err != nil {
+		return newsdto.PublicNewsResponse{}, err
+	}
+
+	categories, tags, err := newsService.getNewsTaxonomy(news.ID)
+	if err != nil {
+		return newsdto.PublicNewsResponse{}, err
+	}
+
+	commentCount, err := newsService.newsCommentRepository.CountNewsComments(newsService.db, news.ID, []enum.CommentStatus{enum.CommentStatusApproved})
+	if err != nil {
+		return newsdto.PublicNewsResponse{}, err
+	}
+
+	content, err := newsService.renderNewsContent(news)
+	if err != nil {
+		return newsdto.PublicNewsResponse{}, err
+	}
+
 	return newsdto.PublicNewsResponse{
-		ID:          news.ID,
-		Title:       news.Title,
-		Content:     news.Content,
-		Description: news.Description,
-		CoverImage:  coverImage,
+		ID:           news.ID,
+		Title:        news.Title,
+		Slug:         news.Slug,
+		Content:      content,
+		Description:  news.Description,
+		CoverImage:   coverImage,
+		SEO:          seo,
+		Categories:   categories,
+		Tags:         tags,
+		CommentCount: commentCount,
+		Locale:       locale,
 	}, nil
 }
 
+// getNewsSEO falls back to the title, description and cover image when no
+// dedicated SEO values were set.
+func (newsService *NewsService) getNewsSEO(news *entity.News) (newsdto.NewsSEOResponse, error) {
+	seo := newsdto.NewsSEOResponse{
+		MetaTitle:       news.MetaTitle,
+		MetaDescription: news.MetaDescription,
+	}
+	if seo.MetaTitle == "" {
+		seo.MetaTitle = news.Title
+	}
+	if seo.MetaDescription == "" {
+		seo.MetaDescription = news.Description
+	}
+
+	ogImage := news.OGImage
+	if ogImage == "" {
+		ogImage = news.CoverImage
+	}
+	ogImageURLs, err := newsService.imageService.GetImageURLs(enum.NewsMedia, ogImage, 8*time.Hour)
+	if err != nil {
+		return newsdto.NewsSEOResponse{}, err
+	}
+	seo.OGImage = ogImageURLs
+	return seo, nil
+}
+
 func (newsService *NewsService) GetAdminNewsList(request newsdto.GetAdminNewsListRequest) ([]newsdto.AdminNewsResponse, error) {
 	paginationModifier := postgresImpl.NewPaginationModifier(request.Limit, request.Offset)
 	sortingModifier := postgresImpl.NewSortingModifier("created_at", true)
@@ -172,12 +352,9 @@ func (newsService *NewsService) GetAdminNewsList(request newsdto.GetAdminNewsLis
 	newsResponse := make([]newsdto.AdminNewsResponse, len(news))
 
 	for i, eachNews := range news {
-		coverImage := ""
-		if eachNews.CoverImage != "" {
-			coverImage, err = newsService.s3Storage.GetPresignedURL(enum.NewsMedia, eachNews.CoverImage, 8*time.Hour)
-			if err != nil {
-				return nil, err
-			}
+		coverImage, err := newsService.imageService.GetImageURLs(enum.NewsMedia, eachNews.CoverImage, 8*time.Hour)
+		if err != nil {
+			return nil, err
 		}
 
 		author, err := newsService.userService.GetUserCredential(eachNews.AuthorID)
@@ -185,14 +362,35 @@ func (newsService *NewsService) GetAdminNewsList(request newsdto.GetAdminNewsLis
 			return nil, err
 		}
 
+		seo, err := newsService.getNewsSEO(eachNews)
+		if err != nil {
+			return nil, err
+		}
+
+		categories, tags, err := newsService.getNewsTaxonomy(eachNews.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := newsService.renderNewsContent(eachNews)
+		if err != nil {
+			return nil, err
+		}
+
 		newsResponse[i] = newsdto.AdminNewsResponse{
 			ID:          eachNews.ID,
 			Title:       eachNews.Title,
-			Content:     eachNews.Content,
+			Slug:        eachNews.Slug,
+			Content:     content,
 			Description: eachNews.Description,
 			Status:      eachNews.Status.String(),
 			CoverImage:  coverImage,
 			Author:      author,
+			PublishAt:   eachNews.PublishAt,
+			UnpublishAt: eachNews.UnpublishAt,
+			SEO:         seo,
+			Categories:  categories,
+			Tags:        tags,
 		}
 	}
 	return newsResponse, nil
@@ -202,39 +400,86 @@ func (newsService *NewsService) GetPublicNewsList(request newsdto.GetPublicNewsL
 	paginationModifier := postgresImpl.NewPaginationModifier(request.Limit, request.Offset)
 	sortingModifier := postgresImpl.NewSortingModifier("created_at", true)
 
+	taxonomyModifier := postgresImpl.NewNewsTaxonomyModifier(request.CategorySlug, request.TagSlug)
+
 	allowedStatuses := []enum.NewsStatus{enum.NewsStatusActive}
-	news, err := newsService.newsRepository.FindNewsByStatus(newsService.db, allowedStatuses, paginationModifier, sortingModifier)
+	news, err := newsService.newsRepository.FindNewsByStatus(newsService.db, allowedStatuses, taxonomyModifier, paginationModifier, sortingModifier)
 	if err != nil {
 		return nil, err
 	}
 	newsResponse := make([]newsdto.PublicNewsResponse, len(news))
 
 	for i, eachNews := range news {
-		coverImage := ""
-		if eachNews.CoverImage != "" {
-			coverImage, err = newsService.s3Storage.GetPresignedURL(enum.NewsMedia, eachNews.CoverImage, 8*time.Hour)
-			if err != nil {
-				return nil, err
-			}
+		newsResponse[i], err = newsService.getPublicNewsResponse(eachNews, request.Locale)
+		if err != nil {
+			return nil, err
 		}
+	}
+	return newsResponse, nil
+}
 
-		newsResponse[i] = newsdto.PublicNewsResponse{
-			ID:          eachNews.ID,
-			Title:       eachNews.Title,
-			Content:     eachNews.Content,
-			Description: eachNews.Description,
-			CoverImage:  coverImage,
+var persianDigits = strings.NewReplacer(
+	"۰", "0", "۱", "1", "۲", "2", "۳", "3", "۴", "4", "۵", "5", "۶", "6", "۷", "7", "۸", "8", "۹", "9",
+	"٠", "0", "١", "1", "٢", "2", "٣", "3", "٤", "4", "٥", "5", "٦", "6", "٧", "7", "٨", "8", "٩", "9",
+	"ي", "ی", "ك", "ک", "ة", "ه",
+)
+
+const maxSlugLength = 80
+
+// slugify keeps Persian and Latin letters as they are so titles stay readable in
+// the URL, and turns everything else, including the zero-width non-joiner, into
+// single dashes.
+func slugify(title string) string {
+	var builder strings.Builder
+	pendingDash := false
+	length := 0
+	for _, r := range persianDigits.Replace(strings.ToLower(title)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
 		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			pendingDash = builder.Len() > 0
+			continue
+		}
+		if length >= maxSlugLength || (pendingDash && length+1 >= maxSlugLength) {
+			break
+		}
+		if pendingDash {
+			builder.WriteRune('-')
+			length++
+			pendingDash = false
+		}
+		builder.WriteRune(r)
+		length++
+	}
+	slug := builder.String()
+	if slug == "" {
+		return "news"
+	}
+	return slug
+}
+
+func (newsService *NewsService) generateUniqueSlug(title string, newsID uint) (string, error) {
+	base := slugify(title)
+	slug := base
+	for suffix := 2; ; suffix++ {
+		taken, err := newsService.newsRepository.IsSlugTaken(newsService.db, slug, newsID)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, suffix)
 	}
-	return newsResponse, nil
 }
 
-func (newsService *NewsService) checkDuplicateNews(title string) error {
-	news, err := newsService.newsRepository.FindNewsByTittle(newsService.db, title)
+func (newsService *NewsService) checkDuplicateNews(title string, newsID uint) error {
+	news, err := newsService.newsRepository.FindNewsByTittle(newsService.db, title, slugify(title))
 	if err != nil {
 		return err
 	}
-	if news != nil {
+	if news != nil && news.ID != newsID {
 		var conflictErrors exception.ConflictErrors
 		conflictErrors.Add(newsService.constants.Field.Name, newsService.constants.Tag.AlreadyExist)
 		return conflictErrors
@@ -242,30 +487,154 @@ func (newsService *NewsService) checkDuplicateNews(title string) error {
 	return nil
 }
 
+const mediaReferencePrefix = "media:"
+
+// toMediaReference replaces links to media of the news with a stable
+// "media:<id>" reference, since presigned URLs expire. References to media
+// that does not belong to the news are dropped.
+func (newsService *NewsService) toMediaReference(value string, media []*entity.Media) (string, bool) {
+	if idText, ok := strings.CutPrefix(value, mediaReferencePrefix); ok {
+		mediaID, err := strconv.ParseUint(idText, 10, 64)
+		if err != nil {
+			return "", false
+		}
+		for _, eachMedia := range media {
+			if eachMedia.ID == uint(mediaID) {
+				return value, true
+			}
+		}
+		return "", false
+	}
+
+	parsedURL, err := url.Parse(value)
+	if err != nil {
+		return value, true
+	}
+	for _, eachMedia := range media {
+		if eachMedia.Path != "" && strings.HasSuffix(parsedURL.Path, "/"+eachMedia.Path) {
+			return mediaReferencePrefix + strconv.FormatUint(uint64(eachMedia.ID), 10), true
+		}
+	}
+	return value, true
+}
+
+// PrepareNewsContent sanitizes the editor HTML before it is stored and derives
+// the description from it when none was given.
+func (newsService *NewsService) PrepareNewsContent(news *entity.News) error {
+	var media []*entity.Media
+	if news.ID != 0 {
+		var err error
+		media, err = newsService.newsRepository.FindNewsMedia(newsService.db, news.ID, "news")
+		if err != nil {
+			return err
+		}
+	}
+
+	news.Content = newsService.htmlSanitizer.Sanitize(news.Content, func(tag, attribute, value string) (string, bool) {
+		return newsService.toMediaReference(value, media)
+	})
+
+	if strings.TrimSpace(news.Description) == "" {
+		news.Description = excerpt(newsService.htmlSanitizer.PlainText(news.Content), newsService.newsConfig.ExcerptLength)
+	}
+	return nil
+}
+
+// renderNewsContent resolves media references to presigned URLs. The content
+// goes through the sanitizer again so news stored before sanitization was
+// introduced is never served verbatim.
+func (newsService *NewsService) renderNewsContent(news *entity.News) (string, error) {
+	mediaURLs := make(map[string]string)
+	if strings.Contains(news.Content, mediaReferencePrefix) {
+		media, err := newsService.newsRepository.FindNewsMedia(newsService.db, news.ID, "news")
+		if err != nil {
+			return "", err
+		}
+		for _, eachMedia := range media {
+			presignedURL, err := newsService.s3Storage.GetPresignedURL(enum.NewsMedia, eachMedia.Path, 8*time.Hour)
+			if err != nil {
+				return "", err
+			}
+			mediaURLs[mediaReferencePrefix+strconv.FormatUint(uint64(eachMedia.ID), 10)] = presignedURL
+		}
+	}
+
+	content := newsService.htmlSanitizer.Sanitize(news.Content, func(tag, attribute, value string) (string, bool) {
+		if !strings.HasPrefix(value, mediaReferencePrefix) {
+			return value, true
+		}
+		presignedURL, ok := mediaURLs[value]
+		return presignedURL, ok
+	})
+	return content, nil
+}
+
+// excerpt cuts text to at most length runes, preferring a word boundary.
+func excerpt(text string, length int) string {
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+	cut := runes[:length]
+	if unicode.IsSpace(runes[length]) {
+		return strings.TrimSpace(string(cut)) + "…"
+	}
+	for i := len(cut) - 1; i > length/2; i-- {
+		if unicode.IsSpace(cut[i]) {
+			cut = cut[:i]
+			break
+		}
+	}
+	return strings.TrimSpace(string(cut)) + "…"
+}
+
 func (newsService *NewsService) CreateNews(request newsdto.CreateNewsRequest) (uint, error) {
 	if err := newsService.userService.IsUserActive(request.AuthorID); err != nil {
 		return 0, nil
 	}
 
-	if err := newsService.checkDuplicateNews(request.Title); err != nil {
+	if err := newsService.checkDuplicateNews(request.Title, 0); err != nil {
+		return 0, err
+	}
+
+	coverImageName := ""
+	if request.CoverImage != nil {
+		var err error
+		coverImageName, err = newsService.uploadService.ValidateUpload(enum.NewsMedia, request.CoverImage)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	slug, err := newsService.generateUniqueSlug(request.Title, 0)
+	if err != nil {
 		return 0, err
 	}
 
 	news := &entity.News{
 		Title:       request.Title,
+		Slug:        slug,
 		Content:     request.Content,
 		Description: request.Description,
 		AuthorID:    request.AuthorID,
 		Status:      request.Status,
 	}
-	err := newsService.db.WithTransaction(func(tx database.Database) error {
+	markPublished(news, time.Now())
+	if err := newsService.PrepareNewsContent(news); err != nil {
+		return 0, err
+	}
+
+	err = newsService.db.WithTransaction(func(tx database.Database) error {
 		if err := newsService.newsRepository.CreateNews(tx, news); err != nil {
 			return err
 		}
+		if err := newsService.recordRevision(tx, nil, news, request.AuthorID, nil); err != nil {
+			return err
+		}
 
 		if request.CoverImage != nil {
-			news.CoverImage = newsService.constants.S3BucketPath.GetNewsCoverImagePath(news.ID, request.CoverImage.Filename)
-			if err := newsService.s3Storage.UploadObject(enum.NewsMedia, news.CoverImage, request.CoverImage); err != nil {
+			news.CoverImage = newsService.constants.S3BucketPath.GetNewsCoverImagePath(news.ID, coverImageName)
+			if err := newsService.imageService.UploadImage(enum.NewsMedia, news.CoverImage, request.CoverImage); err != nil {
 				return err
 			}
 
@@ -280,9 +649,20 @@ func (newsService *NewsService) CreateNews(request newsdto.CreateNewsRequest) (u
 		return 0, err
 	}
 
+	if err := newsService.feedService.InvalidateFeeds(); err != nil {
+		return 0, err
+	}
 	return news.ID, nil
 }
 
+// markPublished stamps when a news first went live. Republishing keeps the original
+// date so feeds do not resurface old news.
+func markPublished(news *entity.News, at time.Time) {
+	if news.Status == enum.NewsStatusActive && news.PublishedAt == nil {
+		news.PublishedAt = &at
+	}
+}
+
 func (newsService *NewsService) checkStatusConflict(newStatus, oldStatus enum.NewsStatus) error {
 	var conflictErrors exception.ConflictErrors
 	if newStatus == enum.NewsStatusActive && oldStatus == enum.NewsStatusActive {
@@ -296,6 +676,84 @@ func (newsService *NewsService) checkStatusConflict(newStatus, oldStatus enum.Ne
 	return nil
 }
 
+// refreshSlug generates a slug for news whose slug was cleared by a title change
+// or that predate slugs altogether.
+func (newsService *NewsService) refreshSlug(news *entity.News) error {
+	if news.Slug != "" {
+		return nil
+	}
+	slug, err := newsService.generateUniqueSlug(news.Title, news.ID)
+	if err != nil {
+		return err
+	}
+	news.Slug = slug
+	return nil
+}
+
+// recordSlugChange keeps the previous slug reachable as a redirect. A slug the
+// news is taking back is removed from its own redirect history first.
+func (newsService *NewsService) recordSlugChange(tx database.Database, news *entity.News, prevSlug string) error {
+	if news.Slug == prevSlug {
+		return nil
+	}
+	if err := newsService.newsRepository.DeleteNewsSlugRedirect(tx, news.ID, news.Slug); err != nil {
+		return err
+	}
+	if prevSlug == "" {
+		return nil
+	}
+	redirect := &entity.NewsSlugRedirect{
+		NewsID: news.ID,
+		Slug:   prevSlug,
+	}
+	return newsService.newsRepository.CreateNewsSlugRedirect(tx, redirect)
+}
+
+// recordRevision stores the editable text of a news as a new revision. Edits
+// that leave the text untouched are not recorded. News created before revisions
+// existed get their pre-edit state stored first so the edit can be diffed.
+func (newsService *NewsService) recordRevision(tx database.Database, original, news *entity.News, editorID uint, restoredFrom *uint) error {
+	if original != nil && restoredFrom == nil &&
+		original.Title == news.Title &&
+		original.Content == news.Content &&
+		original.Description == news.Description {
+		return nil
+	}
+
+	latest, err := newsService.newsRepository.FindLatestNewsRevision(tx, news.ID)
+	if err != nil {
+		return err
+	}
+	number := uint(1)
+	if latest != nil {
+		number = latest.Number + 1
+	} else if original != nil {
+		baseline := &entity.NewsRevision{
+			NewsID:      original.ID,
+			Number:      number,
+			EditorID:    original.AuthorID,
+			Title:       original.Title,
+			Content:     original.Content,
+			Description: original.Description,
+		}
+		if err := newsService.newsRepository.CreateNewsRevision(tx, baseline); err != nil {
+			return err
+		}
+		number++
+	}
+
+	revision := &entity.NewsRevision{
+		NewsID:       news.ID,
+		Number:       number,
+		EditorID:     editorID,
+		Title:        news.Title,
+		Content:      news.Content,
+		Description:  news.Description,
+		RestoredFrom: restoredFrom,
+	}
+	return newsService.newsRepository.CreateNewsRevision(tx, revision)
+}
+
 func (newsService *NewsService) EditNews(request newsdto.EditNewsRequest) error {
 	if err := newsService.userService.IsUserActive(request.AuthorID); err != nil {
 		return err
@@ -305,12 +763,26 @@ func (newsService *NewsService) EditNews(request newsdto.EditNewsRequest) error
 	if err != nil {
 		return err
 	}
+	original := *news
 
 	if request.Title != nil && *request.Title != news.Title {
-		if err := newsService.checkDuplicateNews(*request.Title); err != nil {
+		if err := newsService.checkDuplicateNews(*request.Title, news.ID); err != nil {
 			return err
 		}
 		news.Title = *request.Title
+		news.Slug = ""
+	}
+
+	if err := newsService.refreshSlug(news); err != nil {
+		return err
+	}
+
+	if request.MetaTitle != nil {
+		news.MetaTitle = *request.MetaTitle
+	}
+
+	if request.MetaDescription != nil {
+		news.MetaDescription = *request.MetaDescription
 	}
 
 	if request.Content != nil {
@@ -321,16 +793,45 @@ func (newsService *NewsService) EditNews(request newsdto.EditNewsRequest) error
 		news.Description = *request.Description
 	}
 
-	newStatus := newsService.mapToOperationalStatuses(request.Status)
-	if err := newsService.checkStatusConflict(newStatus, news.Status); err != nil {
+	if err := newsService.PrepareNewsContent(news); err != nil {
 		return err
 	}
-	news.Status = newStatus
 
-	prevCoverPath := news.CoverImage
+	if request.Status != 0 || news.Status != enum.NewsStatusScheduled {
+		newStatus := newsService.mapToOperationalStatuses(request.Status)
+		if err := newsService.checkStatusConflict(newStatus, news.Status); err != nil {
+			return err
+		}
+		news.Status = newStatus
+		news.PublishAt = nil
+		markPublished(news, time.Now())
+		if newStatus == enum.NewsStatusDraft {
+			news.UnpublishAt = nil
+		}
+	}
+
+	prevCoverPath := ""
 	if request.CoverImage != nil {
-		news.CoverImage = newsService.constants.S3BucketPath.GetNewsCoverImagePath(news.ID, request.CoverImage.Filename)
-		if err := newsService.s3Storage.UploadObject(enum.NewsMedia, news.CoverImage, request.CoverImage); err != nil {
+		coverImageName, err := newsService.uploadService.ValidateUpload(enum.NewsMedia, request.CoverImage)
+		if err != nil {
+			return err
+		}
+		prevCoverPath = news.CoverImage
+		news.CoverImage = newsService.constants.S3BucketPath.GetNewsCoverImagePath(news.ID, coverImageName)
+		if err := newsService.imageService.UploadImage(enum.NewsMedia, news.CoverImage, request.CoverImage); err != nil {
+			return err
+		}
+	}
+
+	prevOGImagePath := ""
+	if request.OGImage != nil {
+		ogImageName, err := newsService.uploadService.ValidateUpload(enum.NewsMedia, request.OGImage)
+		if err != nil {
+			return err
+		}
+		prevOGImagePath = news.OGImage
+		news.OGImage = newsService.constants.S3BucketPath.GetNewsOGImagePath(news.ID, ogImageName)
+		if err := newsService.imageService.UploadImage(enum.NewsMedia, news.OGImage, request.OGImage); err != nil {
 			return err
 		}
 	}
@@ -338,15 +839,29 @@ func (newsService *NewsService) EditNews(request newsdto.EditNewsRequest) error
 		if err := newsService.newsRepository.UpdateNews(tx, news); err != nil {
 			return err
 		}
+		if err := newsService.recordSlugChange(tx, news, original.Slug); err != nil {
+			return err
+		}
+		if err := newsService.recordRevision(tx, &original, news, request.AuthorID, nil); err != nil {
+			return err
+		}
+		if prevOGImagePath != "" {
+			if err := newsService.imageService.DeleteImage(enum.NewsMedia, prevOGImagePath); err != nil {
+				return err
+			}
+		}
 		if prevCoverPath != "" {
-			if err := newsService.s3Storage.DeleteObject(enum.NewsMedia, prevCoverPath); err != nil {
+			if err := newsService.imageService.DeleteImage(enum.NewsMedia, prevCoverPath); err != nil {
 				return err
 			}
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	return newsService.feedService.InvalidateFeeds()
 }
 
 func (newsService *NewsService) UpdateNewsStatus(request newsdto.EditNewsStatusRequest) error {
@@ -363,10 +878,115 @@ func (newsService *NewsService) UpdateNewsStatus(request newsdto.EditNewsStatusR
 		return err
 	}
 	news.Status = enum.NewsStatus(request.Status)
+	news.PublishAt = nil
+	markPublished(news, time.Now())
+	if news.Status == enum.NewsStatusDraft {
+		news.UnpublishAt = nil
+	}
 
 	if err := newsService.newsRepository.UpdateNews(newsService.db, news); err != nil {
 		return err
 	}
+	return newsService.feedService.InvalidateFeeds()
+}
+
+// ScheduleNews sets when a news goes live and, optionally, when it is taken down.
+// Without a publish time only the unpublish time of an already published news
+// is changed.
+func (newsService *NewsService) ScheduleNews(request newsdto.ScheduleNewsRequest) error {
+	if err := newsService.userService.IsUserActive(request.AuthorID); err != nil {
+		return err
+	}
+
+	news, err := newsService.getNewsByID(request.NewsID)
+	if err != nil {
+		return err
+	}
+
+	var validationErrors exception.ValidationErrors
+	now := time.Now()
+	if request.PublishAt == nil && request.UnpublishAt == nil {
+		validationErrors.Add(newsService.constants.Field.PublishAt, "required")
+		return validationErrors
+	}
+	if request.PublishAt != nil && !request.PublishAt.After(now) {
+		validationErrors.Add(newsService.constants.Field.PublishAt, newsService.constants.Tag.FutureTime)
+	}
+	if request.UnpublishAt != nil {
+		if !request.UnpublishAt.After(now) {
+			validationErrors.Add(newsService.constants.Field.UnpublishAt, newsService.constants.Tag.FutureTime)
+		} else if request.PublishAt != nil && !request.UnpublishAt.After(*request.PublishAt) {
+			validationErrors.Add(newsService.constants.Field.UnpublishAt, newsService.constants.Tag.AfterPublishTime)
+		} else if request.PublishAt == nil && news.PublishAt != nil && !request.UnpublishAt.After(*news.PublishAt) {
+			validationErrors.Add(newsService.constants.Field.UnpublishAt, newsService.constants.Tag.AfterPublishTime)
+		}
+	}
+	if len(validationErrors.Errors) > 0 {
+		return validationErrors
+	}
+
+	if request.PublishAt != nil {
+		news.Status = enum.NewsStatusScheduled
+		news.PublishAt = request.PublishAt
+	} else if news.Status == enum.NewsStatusDraft {
+		var conflictErrors exception.ConflictErrors
+		conflictErrors.Add(newsService.constants.Field.News, newsService.constants.Tag.AlreadyDraft)
+		return conflictErrors
+	}
+	if request.UnpublishAt != nil {
+		news.UnpublishAt = request.UnpublishAt
+	}
+
+	if err := newsService.newsRepository.UpdateNews(newsService.db, news); err != nil {
+		return err
+	}
+	return newsService.feedService.InvalidateFeeds()
+}
+
+// ProcessScheduledNews publishes and unpublishes news whose time has come. Only
+// one replica does the work per run; the others skip while the lock is held.
+func (newsService *NewsService) ProcessScheduledNews() error {
+	ctx := context.Background()
+	lockKey := newsService.constants.RedisKey.GenerateLockKey("news-scheduler")
+	lockTTL := time.Duration(newsService.newsConfig.SchedulerIntervalSeconds) * time.Second
+	token, err := newsService.lockCacheRepository.AcquireLock(ctx, lockKey, lockTTL)
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		return nil
+	}
+	defer newsService.lockCacheRepository.ReleaseLock(ctx, lockKey, token)
+
+	now := time.Now()
+	dueForPublish, err := newsService.newsRepository.FindNewsDueForPublish(newsService.db, now)
+	if err != nil {
+		return err
+	}
+	for _, news := range dueForPublish {
+		news.Status = enum.NewsStatusActive
+		markPublished(news, *news.PublishAt)
+		news.PublishAt = nil
+		if err := newsService.newsRepository.UpdateNews(newsService.db, news); err != nil {
+			return err
+		}
+	}
+
+	dueForUnpublish, err := newsService.newsRepository.FindNewsDueForUnpublish(newsService.db, now)
+	if err != nil {
+		return err
+	}
+	for _, news := range dueForUnpublish {
+		news.Status = enum.NewsStatusDraft
+		news.UnpublishAt = nil
+		if err := newsService.newsRepository.UpdateNews(newsService.db, news); err != nil {
+			return err
+		}
+	}
+
+	if len(dueForPublish) > 0 || len(dueForUnpublish) > 0 {
+		return newsService.feedService.InvalidateFeeds()
+	}
 	return nil
 }
 
@@ -389,7 +1009,7 @@ func (newsService *NewsService) DeleteNewsStatus(request newsdto.DeleteNewsReque
 			return err
 		}
 	}
-	return nil
+	return newsService.feedService.InvalidateFeeds()
 }
 
 func (newsService *NewsService) AddNewsMedia(request newsdto.AddNewsMediaRequest) (uint, error) {
@@ -401,7 +1021,12 @@ func (newsService *NewsService) AddNewsMedia(request newsdto.AddNewsMediaRequest
 		return 0, err
 	}
 
-	mediaPath := newsService.constants.S3BucketPath.GetNewsMediaPath(request.NewsID, request.Media.Filename)
+	mediaName, err := newsService.uploadService.ValidateUpload(enum.NewsMedia, request.Media)
+	if err != nil {
+		return 0, err
+	}
+
+	mediaPath := newsService.constants.S3BucketPath.GetNewsMediaPath(request.NewsID, mediaName)
 	if err := newsService.s3Storage.UploadObject(enum.NewsMedia, mediaPath, request.Media); err != nil {
 		return 0, err
 	}
@@ -450,9 +1075,18 @@ func (newsService *NewsService) GetNewsMedia(request newsdto.AccessMediaRequest)
 		return "", err
 	}
 
-	if request.UserType == enum.UserTypeGuest && news.Status == enum.NewsStatusDraft {
-		notFoundError := exception.NotFoundError{Item: newsService.constants.Field.Media}
-		return "", notFoundError
+	if request.UserType == enum.UserTypeGuest && news.Status != enum.NewsStatusActive {
+		validPreview := false
+		if request.PreviewToken != "" {
+			validPreview, err = newsService.previewService.IsValidPreviewToken(news.ID, request.PreviewToken)
+			if err != nil {
+				return "", err
+			}
+		}
+		if !validPreview {
+			notFoundError := exception.NotFoundError{Item: newsService.constants.Field.Media}
+			return "", notFoundError
+		}
 	}
 
 	media, err := newsService.getNewsMedia(request.MediaID, request.NewsID)
@@ -466,3 +1100,428 @@ func (newsService *NewsService) GetNewsMedia(request newsdto.AccessMediaRequest)
 	}
 	return presignedURL, nil
 }
+
+func (newsService *NewsService) CreateNewsUpload(request newsdto.CreateNewsUploadRequest) (newsdto.NewsUploadResponse, error) {
+	if err := newsService.userService.IsUserActive(request.AuthorID); err != nil {
+		return newsdto.NewsUploadResponse{}, err
+	}
+
+	news, err := newsService.getNewsByID(request.NewsID)
+	if err != nil {
+		return newsdto.NewsUploadResponse{}, err
+	}
+
+	objectName, err := newsService.uploadService.ValidateDirectUpload(enum.NewsMedia, request.ContentType, request.Size)
+	if err != nil {
+		return newsdto.NewsUploadResponse{}, err
+	}
+
+	purpose := enum.UploadPurpose(request.Purpose)
+	key := newsService.constants.S3BucketPath.GetNewsMediaPath(news.ID, objectName)
+	if purpose == enum.UploadPurposeCoverImage {
+		key = newsService.constants.S3BucketPath.GetNewsCoverImagePath(news.ID, objectName)
+	}
+
+	pendingUpload := &entity.PendingUpload{
+		BucketType:  enum.NewsMedia,
+		Key:         key,
+		ContentType: request.ContentType,
+		Size:        request.Size,
+		Purpose:     purpose,
+		OwnerID:     news.ID,
+		OwnerType:   "news",
+		UploaderID:  request.AuthorID,
+	}
+	uploadURL, err := newsService.uploadService.CreateUploadURL(pendingUpload)
+	if err != nil {
+		return newsdto.NewsUploadResponse{}, err
+	}
+
+	return newsdto.NewsUploadResponse{
+		ID:          pendingUpload.ID,
+		URL:         uploadURL,
+		ContentType: pendingUpload.ContentType,
+		ExpiresAt:   pendingUpload.ExpiresAt,
+	}, nil
+}
+
+func (newsService *NewsService) ConfirmNewsUpload(request newsdto.ConfirmNewsUploadRequest) (newsdto.ConfirmNewsUploadResponse, error) {
+	if err := newsService.userService.IsUserActive(request.AuthorID); err != nil {
+		return newsdto.ConfirmNewsUploadResponse{}, err
+	}
+
+	news, err := newsService.getNewsByID(request.NewsID)
+	if err != nil {
+		return newsdto.ConfirmNewsUploadResponse{}, err
+	}
+
+	pendingUpload, err := newsService.uploadService.GetPendingUpload(request.UploadID)
+	if err != nil {
+		return newsdto.ConfirmNewsUploadResponse{}, err
+	}
+	if pendingUpload.OwnerType != "news" || pendingUpload.OwnerID != news.ID {
+		notFoundError := exception.NotFoundError{Item: newsService.constants.Field.Upload}
+		return newsdto.ConfirmNewsUploadResponse{}, notFoundError
+	}
+	if time.Now().After(pendingUpload.ExpiresAt) {
+		var conflictErrors exception.ConflictErrors
+		conflictErrors.Add(newsService.constants.Field.Upload, newsService.constants.Tag.ExpiredItem)
+		return newsdto.ConfirmNewsUploadResponse{}, conflictErrors
+	}
+
+	if err := newsService.uploadService.VerifyPendingUpload(pendingUpload); err != nil {
+		return newsdto.ConfirmNewsUploadResponse{}, err
+	}
+	if pendingUpload.Purpose == enum.UploadPurposeCoverImage {
+		// The cover is sanitized before the news refers to it, so it is never
+		// served with the metadata the client uploaded.
+		if err := newsService.imageService.ProcessStoredImage(enum.NewsMedia, pendingUpload.Key); err != nil {
+			return newsdto.ConfirmNewsUploadResponse{}, err
+		}
+	}
+
+	var response newsdto.ConfirmNewsUploadResponse
+	prevCoverPath := ""
+	err = newsService.db.WithTransaction(func(tx database.Database) error {
+		deleted, err := newsService.pendingUploadRepository.DeletePendingUpload(tx, pendingUpload.ID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			notFoundError := exception.NotFoundError{Item: newsService.constants.Field.Upload}
+			return notFoundError
+		}
+
+		if pendingUpload.Purpose == enum.UploadPurposeCoverImage {
+			prevCoverPath = news.CoverImage
+			news.CoverImage = pendingUpload.Key
+			return newsService.newsRepository.UpdateNews(tx, news)
+		}
+
+		media := &entity.Media{
+			Path:      pendingUpload.Key,
+			OwnerID:   news.ID,
+			OwnerType: "news",
+		}
+		if err := newsService.newsRepository.CreateMedia(tx, media); err != nil {
+			return err
+		}
+		response.MediaID = media.ID
+		return nil
+	})
+	if err != nil {
+		return newsdto.ConfirmNewsUploadResponse{}, err
+	}
+
+	if pendingUpload.Purpose == enum.UploadPurposeCoverImage {
+		if prevCoverPath != "" {
+			if err := newsService.imageService.DeleteImage(enum.NewsMedia, prevCoverPath); err != nil {
+				return newsdto.ConfirmNewsUploadResponse{}, err
+			}
+		}
+		response.CoverImage, err = newsService.imageService.GetImageURLs(enum.NewsMedia, news.CoverImage, 8*time.Hour)
+		if err != nil {
+			return newsdto.ConfirmNewsUploadResponse{}, err
+		}
+	}
+	return response, nil
+}
+
+func (newsService *NewsService) getNewsTaxonomy(newsID uint) ([]newsdto.NewsCategoryResponse, []newsdto.NewsTagResponse, error) {
+	categories, err := newsService.newsRepository.FindNewsCategoriesByNewsID(newsService.db, newsID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tags, err := newsService.newsRepository.FindNewsTagsByNewsID(newsService.db, newsID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	categoriesResponse := make([]newsdto.NewsCategoryResponse, len(categories))
+	for i, category := range categories {
+		categoriesResponse[i] = newsService.mapToNewsCategoryResponse(category)
+	}
+	tagsResponse := make([]newsdto.NewsTagResponse, len(tags))
+	for i, tag := range tags {
+		tagsResponse[i] = newsdto.NewsTagResponse{
+			Name: tag.Name,
+			Slug: tag.Slug,
+		}
+	}
+	return categoriesResponse, tagsResponse, nil
+}
+
+func (newsService *NewsService) mapToNewsCategoryResponse(category entity.NewsCategory) newsdto.NewsCategoryResponse {
+	return newsdto.NewsCategoryResponse{
+		ID:   category.ID,
+		Name: category.Name,
+		Slug: category.Slug,
+	}
+}
+
+func (newsService *NewsService) getNewsCategoryByID(categoryID uint) (*entity.NewsCategory, error) {
+	category, err := newsService.newsRepository.FindNewsCategoryByID(newsService.db, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		notFoundError := exception.NotFoundError{Item: newsService.constants.Field.NewsCategory}
+		return nil, notFoundError
+	}
+	return category, nil
+}
+
+func (newsService *NewsService) checkDuplicateNewsCategory(name string, categoryID uint) error {
+	category, err := newsService.newsRepository.FindNewsCategoryByName(newsService.db, name, slugify(name))
+	if err != nil {
+		return err
+	}
+	if category != nil && category.ID != categoryID {
+		var conflictErrors exception.ConflictErrors
+		conflictErrors.Add(newsService.constants.Field.Name, newsService.constants.Tag.AlreadyExist)
+		return conflictErrors
+	}
+	return nil
+}
+
+func (newsService *NewsService) GetNewsCategories() ([]newsdto.NewsCategoryResponse, error) {
+	categories, err := newsService.newsRepository.FindNewsCategories(newsService.db)
+	if err != nil {
+		return nil, err
+	}
+
+	categoriesResponse := make([]newsdto.NewsCategoryResponse, len(categories))
+	for i, category := range categories {
+		categoriesResponse[i] = newsService.mapToNewsCategoryResponse(*category)
+	}
+	return categoriesResponse, nil
+}
+
+func (newsService *NewsService) CreateNewsCategory(request newsdto.CreateNewsCategoryRequest) (uint, error) {
+	if err := newsService.checkDuplicateNewsCategory(request.Name, 0); err != nil {
+		return 0, err
+	}
+
+	category := &entity.NewsCategory{
+		Name: request.Name,
+		Slug: slugify(request.Name),
+	}
+	if err := newsService.newsRepository.CreateNewsCategory(newsService.db, category); err != nil {
+		return 0, err
+	}
+	if err := newsService.feedService.InvalidateFeeds(); err != nil {
+		return 0, err
+	}
+	return category.ID, nil
+}
+
+func (newsService *NewsService) UpdateNewsCategory(request newsdto.UpdateNewsCategoryRequest) error {
+	category, err := newsService.getNewsCategoryByID(request.CategoryID)
+	if err != nil {
+		return err
+	}
+
+	if request.Name != nil {
+		if err := newsService.checkDuplicateNewsCategory(*request.Name, category.ID); err != nil {
+			return err
+		}
+		category.Name = *request.Name
+		category.Slug = slugify(*request.Name)
+	}
+
+	if err := newsService.newsRepository.UpdateNewsCategory(newsService.db, category); err != nil {
+		return err
+	}
+	return newsService.feedService.InvalidateFeeds()
+}
+
+func (newsService *NewsService) DeleteNewsCategory(categoryID uint) error {
+	if _, err := newsService.getNewsCategoryByID(categoryID); err != nil {
+		return err
+	}
+	if err := newsService.newsRepository.DeleteNewsCategory(newsService.db, categoryID); err != nil {
+		return err
+	}
+	return newsService.feedService.InvalidateFeeds()
+}
+
+// UpdateNewsTaxonomy replaces the categories and tags of a news. Tags are free
+// form; the ones that do not exist yet are created on the fly.
+func (newsService *NewsService) UpdateNewsTaxonomy(request newsdto.UpdateNewsTaxonomyRequest) error {
+	if err := newsService.userService.IsUserActive(request.AuthorID); err != nil {
+		return err
+	}
+
+	news, err := newsService.getNewsByID(request.NewsID)
+	if err != nil {
+		return err
+	}
+
+	categories := []entity.NewsCategory{}
+	if len(request.CategoryIDs) > 0 {
+		categories, err = newsService.newsRepository.FindNewsCategoriesByIDs(newsService.db, request.CategoryIDs)
+		if err != nil {
+			return err
+		}
+		if len(categories) != len(slices.Compact(slices.Sorted(slices.Values(request.CategoryIDs)))) {
+			notFoundError := exception.NotFoundError{Item: newsService.constants.Field.NewsCategory}
+			return notFoundError
+		}
+	}
+
+	tagNames := make(map[string]string)
+	tagSlugs := make([]string, 0, len(request.Tags))
+	for _, name := range request.Tags {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		slug := slugify(name)
+		if _, exists := tagNames[slug]; !exists {
+			tagNames[slug] = name
+			tagSlugs = append(tagSlugs, slug)
+		}
+	}
+
+	err = newsService.db.WithTransaction(func(tx database.Database) error {
+		tags := []entity.NewsTag{}
+		if len(tagSlugs) > 0 {
+			tags, err = newsService.newsRepository.FindNewsTagsBySlugs(tx, tagSlugs)
+			if err != nil {
+				return err
+			}
+		}
+		for _, tag := range tags {
+			delete(tagNames, tag.Slug)
+		}
+		for _, slug := range tagSlugs {
+			name, missing := tagNames[slug]
+			if !missing {
+				continue
+			}
+			tag := entity.NewsTag{
+				Name: name,
+				Slug: slug,
+			}
+			if err := newsService.newsRepository.CreateNewsTag(tx, &tag); err != nil {
+				return err
+			}
+			tags = append(tags, tag)
+		}
+
+		if err := newsService.newsRepository.ReplaceNewsCategories(tx, news, categories); err != nil {
+			return err
+		}
+		return newsService.newsRepository.ReplaceNewsTags(tx, news, tags)
+	})
+	if err != nil {
+		return err
+	}
+
+	return newsService.feedService.InvalidateFeeds()
+}
+
+func (newsService *NewsService) GetNewsTagCloud() ([]newsdto.NewsTagCloudResponse, error) {
+	usages, err := newsService.newsRepository.FindNewsTagUsages(newsService.db, newsService.newsConfig.TagCloudLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	tagCloud := make([]newsdto.NewsTagCloudResponse, len(usages))
+	for i, usage := range usages {
+		tagCloud[i] = newsdto.NewsTagCloudResponse{
+			Name:      usage.Name,
+			Slug:      usage.Slug,
+			NewsCount: usage.NewsCount,
+		}
+	}
+	return tagCloud, nil
+}
+
+// GetRelatedNews ranks other published news by the number of tags they share
+// with the given one, newest first on ties.
+func (newsService *NewsService) GetRelatedNews(request newsdto.GetRelatedNewsRequest) ([]newsdto.PublicNewsResponse, error) {
+	news, err := newsService.getNewsByID(request.NewsID)
+	if err != nil {
+		return nil, err
+	}
+	if news.Status != enum.NewsStatusActive {
+		notFoundError := exception.NotFoundError{Item: newsService.constants.Field.News}
+		return nil, notFoundError
+	}
+
+	tags, err := newsService.newsRepository.FindNewsTagsByNewsID(newsService.db, news.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return []newsdto.PublicNewsResponse{}, nil
+	}
+	tagIDs := make([]uint, len(tags))
+	for i, tag := range tags {
+		tagIDs[i] = tag.ID
+	}
+
+	relatedNews, err := newsService.newsRepository.FindRelatedNews(newsService.db, news.ID, tagIDs, newsService.newsConfig.RelatedNewsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	newsResponse := make([]newsdto.PublicNewsResponse, len(relatedNews))
+	for i, eachNews := range relatedNews {
+		newsResponse[i], err = newsService.getPublicNewsResponse(eachNews, request.Locale)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newsResponse, nil
+}
+
+// RestoreNewsRevision brings back the text of an earlier revision. The restore
+// itself is recorded as a new revision so it can be undone the same way.
+func (newsService *NewsService) RestoreNewsRevision(request newsdto.RestoreNewsRevisionRequest) error {
+	if err := newsService.userService.IsUserActive(request.AuthorID); err != nil {
+		return err
+	}
+
+	news, err := newsService.getNewsByID(request.NewsID)
+	if err != nil {
+		return err
+	}
+	revision, err := newsService.revisionService.GetNewsRevision(request.RevisionID, request.NewsID)
+	if err != nil {
+		return err
+	}
+	original := *news
+
+	if revision.Title != news.Title {
+		if err := newsService.checkDuplicateNews(revision.Title, news.ID); err != nil {
+			return err
+		}
+		news.Title = revision.Title
+		news.Slug = ""
+	}
+	if err := newsService.refreshSlug(news); err != nil {
+		return err
+	}
+	news.Content = revision.Content
+	news.Description = revision.Description
+	if err := newsService.PrepareNewsContent(news); err != nil {
+		return err
+	}
+
+	err = newsService.db.WithTransaction(func(tx database.Database) error {
+		if err := newsService.newsRepository.UpdateNews(tx, news); err != nil {
+			return err
+		}
+		if err := newsService.recordSlugChange(tx, news, original.Slug); err != nil {
+			return err
+		}
+		return newsService.recordRevision(tx, &original, news, request.AuthorID, &revision.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return newsService.feedService.InvalidateFeeds()
+}
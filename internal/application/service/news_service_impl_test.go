@@ -0,0 +1,109 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{
+			name:     "lowercases latin titles",
+			title:    "Summer Skin Care Tips",
+			expected: "summer-skin-care-tips",
+		},
+		{
+			name:     "keeps persian letters",
+			title:    "مراقبت از پوست",
+			expected: "مراقبت-از-پوست",
+		},
+		{
+			name:     "replaces zero-width non-joiner",
+			title:    "کرم‌ها",
+			expected: "کرم-ها",
+		},
+		{
+			name:     "normalizes persian digits and arabic letters",
+			title:    "۱۰ نكته كليدي",
+			expected: "10-نکته-کلیدی",
+		},
+		{
+			name:     "drops diacritics",
+			title:    "مُرطوب کننده",
+			expected: "مرطوب-کننده",
+		},
+		{
+			name:     "collapses punctuation and trims dashes",
+			title:    "  -- Hello,   World! -- ",
+			expected: "hello-world",
+		},
+		{
+			name:     "falls back when nothing is left",
+			title:    "?!",
+			expected: "news",
+		},
+		{
+			name:     "caps the length without a trailing dash",
+			title:    strings.Repeat("a", maxSlugLength-1) + " bc",
+			expected: strings.Repeat("a", maxSlugLength-1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, slugify(test.title))
+		})
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		length   int
+		expected string
+	}{
+		{
+			name:     "keeps short text",
+			text:     "short text",
+			length:   20,
+			expected: "short text",
+		},
+		{
+			name:     "keeps text of exactly the length",
+			text:     "exact",
+			length:   5,
+			expected: "exact",
+		},
+		{
+			name:     "cuts at a word boundary",
+			text:     "the quick brown fox jumps",
+			length:   12,
+			expected: "the quick…",
+		},
+		{
+			name:     "cuts mid word without a late boundary",
+			text:     "a verylongwordthatneverends",
+			length:   10,
+			expected: "a verylong…",
+		},
+		{
+			name:     "counts runes rather than bytes",
+			text:     "مراقبت از پوست در تابستان",
+			length:   14,
+			expected: "مراقبت از پوست…",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, excerpt(test.text, test.length))
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"slices"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type NewsTranslationService struct {
+	constants      *bootstrap.Constants
+	userService    usecase.UserService
+	newsService    usecase.NewsService
+	newsRepository postgres.NewsRepository
+	db             database.Database
+}
+
+func NewNewsTranslationService(
+	constants *bootstrap.Constants,
+	userService usecase.UserService,
+	newsService usecase.NewsService,
+	newsRepository postgres.NewsRepository,
+	db database.Database,
+) *NewsTranslationService {
+	return &NewsTranslationService{
+		constants:      constants,
+		userService:    userService,
+		newsService:    newsService,
+		newsRepository: newsRepository,
+		db:             db,
+	}
+}
+
+func (translationService *NewsTranslationService) getNewsByID(newsID uint) (*entity.News, error) {
+	news, err := translationService.newsRepository.FindNewsByID(translationService.db, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if news == nil {
+		notFoundError := exception.NotFoundError{Item: translationService.constants.Field.News}
+		return nil, notFoundError
+	}
+	return news, nil
+}
+
+func (translationService *NewsTranslationService) validateTranslationLocale(locale string) error {
+	if !slices.Contains(translationService.constants.Locales.GetTranslationLocales(), locale) {
+		var validationErrors exception.ValidationErrors
+		validationErrors.Add(translationService.constants.Field.Locale, translationService.constants.Tag.Invalid)
+		return validationErrors
+	}
+	return nil
+}
+
+func (translationService *NewsTranslationService) GetNewsTranslations(newsID uint) ([]newsdto.NewsTranslationResponse, error) {
+	if _, err := translationService.getNewsByID(newsID); err != nil {
+		return nil, err
+	}
+
+	translations, err := translationService.newsRepository.FindNewsTranslations(translationService.db, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	editorIDs := make([]uint, len(translations))
+	for i, translation := range translations {
+		editorIDs[i] = translation.EditorID
+	}
+	editors, err := translationService.userService.GetUserCredentials(editorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	translationsResponse := make([]newsdto.NewsTranslationResponse, len(translations))
+	for i, translation := range translations {
+		translationsResponse[i] = newsdto.NewsTranslationResponse{
+			Locale:      translation.Locale,
+			Title:       translation.Title,
+			Description: translation.Description,
+			Content:     translation.Content,
+			Editor:      editors[translation.EditorID],
+			UpdatedAt:   translation.UpdatedAt,
+		}
+	}
+	return translationsResponse, nil
+}
+
+// SaveNewsTranslation creates or replaces the translation of a news for one
+// locale. Its content goes through the same sanitization as the original.
+func (translationService *NewsTranslationService) SaveNewsTranslation(request newsdto.SaveNewsTranslationRequest) error {
+	if err := translationService.userService.IsUserActive(request.AuthorID); err != nil {
+		return err
+	}
+	if err := translationService.validateTranslationLocale(request.Locale); err != nil {
+		return err
+	}
+
+	news, err := translationService.getNewsByID(request.NewsID)
+	if err != nil {
+		return err
+	}
+
+	translatedNews := &entity.News{
+		Content:     request.Content,
+		Description: request.Description,
+	}
+	translatedNews.ID = news.ID
+	if err := translationService.newsService.PrepareNewsContent(translatedNews); err != nil {
+		return err
+	}
+
+	translation, err := translationService.newsRepository.FindNewsTranslation(translationService.db, news.ID, request.Locale)
+	if err != nil {
+		return err
+	}
+	if translation == nil {
+		translation = &entity.NewsTranslation{
+			NewsID: news.ID,
+			Locale: request.Locale,
+		}
+	}
+	translation.Title = request.Title
+	translation.Description = translatedNews.Description
+	translation.Content = translatedNews.Content
+	translation.EditorID = request.AuthorID
+	return translationService.newsRepository.SaveNewsTranslation(translationService.db, translation)
+}
+
+func (translationService *NewsTranslationService) DeleteNewsTranslation(request newsdto.DeleteNewsTranslationRequest) error {
+	if err := translationService.userService.IsUserActive(request.AuthorID); err != nil {
+		return err
+	}
+
+	translation, err := translationService.newsRepository.FindNewsTranslation(translationService.db, request.NewsID, request.Locale)
+	if err != nil {
+		return err
+	}
+	if translation == nil {
+		notFoundError := exception.NotFoundError{Item: translationService.constants.Field.NewsTranslation}
+		return notFoundError
+	}
+
+	return translationService.newsRepository.DeleteNewsTranslation(translationService.db, translation.ID)
+}
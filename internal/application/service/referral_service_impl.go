@@ -0,0 +1,296 @@
+package service
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	referraldto "github.com/CosmeticsShiraz/Backend/internal/application/dto/referral"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	postgresImpl "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
+)
+
+type ReferralService struct {
+	constants          *bootstrap.Constants
+	referralConfig     *bootstrap.Referral
+	userService        usecase.UserService
+	userRepository     postgres.UserRepository
+	addressRepository  postgres.AddressRepository
+	referralRepository postgres.ReferralRepository
+	db                 database.Database
+}
+
+func NewReferralService(
+	constants *bootstrap.Constants,
+	referralConfig *bootstrap.Referral,
+	userService usecase.UserService,
+	userRepository postgres.UserRepository,
+	addressRepository postgres.AddressRepository,
+	referralRepository postgres.ReferralRepository,
+	db database.Database,
+) *ReferralService {
+	return &ReferralService{
+		constants:          constants,
+		referralConfig:     referralConfig,
+		userService:        userService,
+		userRepository:     userRepository,
+		addressRepository:  addressRepository,
+		referralRepository: referralRepository,
+		db:                 db,
+	}
+}
+
+var referralCodeTable = []byte("ABCDEFGHJKLMNPQRSTUVWXYZ23456789")
+
+func (referralService *ReferralService) generateReferralCode() (string, error) {
+	length := referralService.referralConfig.CodeLength
+	for attempt := 0; attempt < 5; attempt++ {
+		code := make([]byte, length)
+		if _, err := io.ReadFull(rand.Reader, code); err != nil {
+			return "", err
+		}
+		for i := 0; i < len(code); i++ {
+			code[i] = referralCodeTable[int(code[i])%len(referralCodeTable)]
+		}
+
+		owner, err := referralService.userRepository.FindUserByReferralCode(referralService.db, string(code))
+		if err != nil {
+			return "", err
+		}
+		if owner == nil {
+			return string(code), nil
+		}
+	}
+	return "", fmt.Errorf("unable to generate unique referral code")
+}
+
+func (referralService *ReferralService) mapToFilterStatuses(enumStatus uint) []enum.ReferralStatus {
+	statuses := enum.GetAllReferralStatuses()
+	for _, status := range statuses {
+		if uint(status) == enumStatus {
+			if status == enum.ReferralStatusAll {
+				return statuses
+			}
+			return []enum.ReferralStatus{status}
+		}
+	}
+	return statuses
+}
+
+func (referralService *ReferralService) GetAllReferralStatuses() []referraldto.ReferralStatusesResponse {
+	allowedStatuses := []enum.ReferralStatus{
+		enum.ReferralStatusPending,
+		enum.ReferralStatusConverted,
+		enum.ReferralStatusRejected,
+		enum.ReferralStatusUnderReview,
+	}
+
+	statuses := make([]referraldto.ReferralStatusesResponse, len(allowedStatuses))
+	for i, status := range allowedStatuses {
+		statuses[i] = referraldto.ReferralStatusesResponse{
+			ID:   uint(status),
+			Name: status.String(),
+		}
+	}
+	return statuses
+}
+
+func (referralService *ReferralService) GetUserReferral(userID uint) (referraldto.UserReferralResponse, error) {
+	user, err := referralService.userService.GetUserByID(userID)
+	if err != nil {
+		return referraldto.UserReferralResponse{}, err
+	}
+
+	if user.ReferralCode == nil {
+		code, err := referralService.generateReferralCode()
+		if err != nil {
+			return referraldto.UserReferralResponse{}, err
+		}
+		if err := referralService.userRepository.UpdateUserReferralCode(referralService.db, user.ID, code); err != nil {
+			return referraldto.UserReferralResponse{}, err
+		}
+		// A concurrent request may have stored its own code first; return the stored one.
+		user, err = referralService.userService.GetUserByID(userID)
+		if err != nil {
+			return referraldto.UserReferralResponse{}, err
+		}
+	}
+
+	invited, err := referralService.referralRepository.CountReferrerReferrals(referralService.db, user.ID, []enum.ReferralStatus{enum.ReferralStatusPending, enum.ReferralStatusConverted})
+	if err != nil {
+		return referraldto.UserReferralResponse{}, err
+	}
+	converted, err := referralService.referralRepository.CountReferrerReferrals(referralService.db, user.ID, []enum.ReferralStatus{enum.ReferralStatusConverted})
+	if err != nil {
+		return referraldto.UserReferralResponse{}, err
+	}
+
+	return referraldto.UserReferralResponse{
+		Code:         *user.ReferralCode,
+		RewardPoints: user.RewardPoints,
+		Invited:      invited,
+		Converted:    converted,
+	}, nil
+}
+
+func (referralService *ReferralService) isSharedAddress(db database.Database, referrerID, refereeID uint) (bool, error) {
+	refereeAddresses, err := referralService.addressRepository.GetOwnerAddresses(db, refereeID, referralService.constants.AddressOwners.User)
+	if err != nil {
+		return false, err
+	}
+	referrerAddresses, err := referralService.addressRepository.GetOwnerAddresses(db, referrerID, referralService.constants.AddressOwners.User)
+	if err != nil {
+		return false, err
+	}
+
+	for _, refereeAddress := range refereeAddresses {
+		for _, referrerAddress := range referrerAddresses {
+			if refereeAddress.PostalCode == referrerAddress.PostalCode &&
+				refereeAddress.HouseNumber == referrerAddress.HouseNumber &&
+				refereeAddress.Unit == referrerAddress.Unit {
+				return true, nil
+			}
+		}
+
+		count, err := referralService.referralRepository.CountRewardedAddresses(db, refereeAddress, refereeID)
+		if err != nil {
+			return false, err
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ConvertReferral rewards both parties of a pending referral. It should be called
+// once the referee completes their first paid order. The referral row stays
+// locked until the rewards are credited, so a referral converts at most once.
+func (referralService *ReferralService) ConvertReferral(refereeID uint) error {
+	return referralService.db.WithTransaction(func(tx database.Database) error {
+		referral, err := referralService.referralRepository.FindReferralByRefereeIDForUpdate(tx, refereeID)
+		if err != nil {
+			return err
+		}
+		if referral == nil || referral.Status != enum.ReferralStatusPending {
+			return nil
+		}
+
+		sharedAddress, err := referralService.isSharedAddress(tx, referral.ReferrerID, referral.RefereeID)
+		if err != nil {
+			return err
+		}
+		if sharedAddress {
+			referral.Status = enum.ReferralStatusRejected
+			return referralService.referralRepository.UpdateReferral(tx, referral)
+		}
+
+		now := time.Now()
+		referral.Status = enum.ReferralStatusConverted
+		referral.ConvertedAt = &now
+		referral.ReferrerReward = uint(referralService.referralConfig.ReferrerReward)
+		referral.RefereeReward = uint(referralService.referralConfig.RefereeReward)
+		if err := referralService.referralRepository.UpdateReferral(tx, referral); err != nil {
+			return err
+		}
+		if err := referralService.userRepository.AddUserRewardPoints(tx, referral.ReferrerID, referral.ReferrerReward); err != nil {
+			return err
+		}
+		return referralService.userRepository.AddUserRewardPoints(tx, referral.RefereeID, referral.RefereeReward)
+	})
+}
+
+// ReviewReferral releases a referral held for review, either back to pending so
+// it is rewarded with the first order, or to rejected.
+func (referralService *ReferralService) ReviewReferral(request referraldto.ReviewReferralRequest) error {
+	return referralService.db.WithTransaction(func(tx database.Database) error {
+		referral, err := referralService.referralRepository.FindReferralByIDForUpdate(tx, request.ReferralID)
+		if err != nil {
+			return err
+		}
+		if referral == nil {
+			notFoundError := exception.NotFoundError{Item: referralService.constants.Field.Referral}
+			return notFoundError
+		}
+		if referral.Status != enum.ReferralStatusUnderReview {
+			var conflictErrors exception.ConflictErrors
+			conflictErrors.Add(referralService.constants.Field.Referral, referralService.constants.Tag.AlreadyResolved)
+			return conflictErrors
+		}
+
+		referral.Status = enum.ReferralStatus(request.Status)
+		return referralService.referralRepository.UpdateReferral(tx, referral)
+	})
+}
+
+func (referralService *ReferralService) GetReferralReport(request referraldto.GetReferralReportRequest) ([]referraldto.ReferralResponse, error) {
+	paginationModifier := postgresImpl.NewPaginationModifier(request.Limit, request.Offset)
+	sortingModifier := postgresImpl.NewSortingModifier("created_at", true)
+
+	allowedStatuses := referralService.mapToFilterStatuses(request.Status)
+	referrals, err := referralService.referralRepository.FindReferralsByStatus(referralService.db, allowedStatuses, paginationModifier, sortingModifier)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint, 0, 2*len(referrals))
+	for _, referral := range referrals {
+		userIDs = append(userIDs, referral.ReferrerID, referral.RefereeID)
+	}
+	users, err := referralService.userService.GetUserCredentials(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	referralsResponse := make([]referraldto.ReferralResponse, len(referrals))
+	for i, referral := range referrals {
+		referralsResponse[i] = referraldto.ReferralResponse{
+			ID:             referral.ID,
+			Referrer:       users[referral.ReferrerID],
+			Referee:        users[referral.RefereeID],
+			Status:         referral.Status.String(),
+			ReferrerReward: referral.ReferrerReward,
+			RefereeReward:  referral.RefereeReward,
+			CreatedAt:      referral.CreatedAt,
+			ConvertedAt:    referral.ConvertedAt,
+		}
+	}
+	return referralsResponse, nil
+}
+
+func (referralService *ReferralService) GetReferralSummary() (referraldto.ReferralSummaryResponse, error) {
+	pending, err := referralService.referralRepository.CountReferralsByStatus(referralService.db, enum.ReferralStatusPending)
+	if err != nil {
+		return referraldto.ReferralSummaryResponse{}, err
+	}
+	converted, err := referralService.referralRepository.CountReferralsByStatus(referralService.db, enum.ReferralStatusConverted)
+	if err != nil {
+		return referraldto.ReferralSummaryResponse{}, err
+	}
+	rejected, err := referralService.referralRepository.CountReferralsByStatus(referralService.db, enum.ReferralStatusRejected)
+	if err != nil {
+		return referraldto.ReferralSummaryResponse{}, err
+	}
+	underReview, err := referralService.referralRepository.CountReferralsByStatus(referralService.db, enum.ReferralStatusUnderReview)
+	if err != nil {
+		return referraldto.ReferralSummaryResponse{}, err
+	}
+	awardedPoints, err := referralService.referralRepository.SumConvertedRewards(referralService.db)
+	if err != nil {
+		return referraldto.ReferralSummaryResponse{}, err
+	}
+
+	return referraldto.ReferralSummaryResponse{
+		Pending:       pending,
+		Converted:     converted,
+		Rejected:      rejected,
+		UnderReview:   underReview,
+		AwardedPoints: awardedPoints,
+	}, nil
+}
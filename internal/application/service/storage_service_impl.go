@@ -0,0 +1,55 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	storagedto "github.com/CosmeticsShiraz/Backend/internal/application/dto/storage"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/s3"
+)
+
+type StorageService struct {
+	constants         *bootstrap.Constants
+	signedObjectStore s3.SignedObjectStore
+}
+
+func NewStorageService(
+	constants *bootstrap.Constants,
+	signedObjectStore s3.SignedObjectStore,
+) *StorageService {
+	return &StorageService{
+		constants:         constants,
+		signedObjectStore: signedObjectStore,
+	}
+}
+
+func (storageService *StorageService) GetSignedObject(request storagedto.GetSignedObjectRequest) (storagedto.ObjectResponse, error) {
+	if !storageService.signedObjectStore.VerifySignature(http.MethodGet, request.Bucket, request.Key, "", request.Expires, request.Signature) {
+		forbiddenError := exception.ForbiddenError{Resource: storageService.constants.Field.File}
+		return storagedto.ObjectResponse{}, forbiddenError
+	}
+
+	data, contentType, err := storageService.signedObjectStore.ReadObject(request.Bucket, request.Key)
+	if err != nil {
+		return storagedto.ObjectResponse{}, err
+	}
+	if data == nil {
+		notFoundError := exception.NotFoundError{Item: storageService.constants.Field.File}
+		return storagedto.ObjectResponse{}, notFoundError
+	}
+
+	return storagedto.ObjectResponse{
+		Data:        data,
+		ContentType: contentType,
+	}, nil
+}
+
+func (storageService *StorageService) PutSignedObject(request storagedto.PutSignedObjectRequest) error {
+	if !storageService.signedObjectStore.VerifySignature(http.MethodPut, request.Bucket, request.Key, request.ContentType, request.Expires, request.Signature) {
+		forbiddenError := exception.ForbiddenError{Resource: storageService.constants.Field.File}
+		return forbiddenError
+	}
+
+	return storageService.signedObjectStore.WriteObject(request.Bucket, request.Key, request.Body)
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/s3"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/scanner"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	postgresImpl "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
+)
+
+type uploadPolicy struct {
+	contentTypes []string
+	maxSize      int64
+}
+
+var uploadExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"video/mp4":       ".mp4",
+	"video/webm":      ".webm",
+	"application/pdf": ".pdf",
+}
+
+type UploadService struct {
+	constants               *bootstrap.Constants
+	uploadConfig            *bootstrap.Upload
+	malwareScanner          scanner.MalwareScanner
+	s3Storage               s3.S3Storage
+	pendingUploadRepository postgres.PendingUploadRepository
+	db                      database.Database
+	policies                map[enum.BucketType]uploadPolicy
+}
+
+func NewUploadService(
+	constants *bootstrap.Constants,
+	uploadConfig *bootstrap.Upload,
+	malwareScanner scanner.MalwareScanner,
+	s3Storage s3.S3Storage,
+	pendingUploadRepository postgres.PendingUploadRepository,
+	db database.Database,
+) *UploadService {
+	const megabyte = 1 << 20
+	imagePolicy := uploadPolicy{
+		contentTypes: []string{"image/jpeg", "image/png"},
+		maxSize:      int64(uploadConfig.ImageMaxSizeMB) * megabyte,
+	}
+	documentPolicy := uploadPolicy{
+		contentTypes: []string{"application/pdf", "image/jpeg", "image/png"},
+		maxSize:      int64(uploadConfig.DocumentMaxSizeMB) * megabyte,
+	}
+	mediaPolicy := uploadPolicy{
+		contentTypes: []string{"image/jpeg", "image/png", "image/gif", "image/webp", "video/mp4", "video/webm"},
+		maxSize:      int64(uploadConfig.MediaMaxSizeMB) * megabyte,
+	}
+
+	return &UploadService{
+		constants:               constants,
+		uploadConfig:            uploadConfig,
+		malwareScanner:          malwareScanner,
+		s3Storage:               s3Storage,
+		pendingUploadRepository: pendingUploadRepository,
+		db:                      db,
+		policies: map[enum.BucketType]uploadPolicy{
+			enum.VATTaxpayerCertificate: documentPolicy,
+			enum.OfficialNewspaperAD:    documentPolicy,
+			enum.ProfilePic:             imagePolicy,
+			enum.LogoPic:                imagePolicy,
+			enum.NewsMedia:              mediaPolicy,
+		},
+	}
+}
+
+func (uploadService *UploadService) generateObjectName(contentType string) (string, error) {
+	name := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, name); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(name) + uploadExtensions[contentType], nil
+}
+
+func (uploadService *UploadService) checkImageDimensions(data []byte) bool {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+	minDimension := uploadService.uploadConfig.MinImageDimension
+	maxDimension := uploadService.uploadConfig.MaxImageDimension
+	return config.Width >= minDimension && config.Height >= minDimension &&
+		config.Width <= maxDimension && config.Height <= maxDimension
+}
+
+func (uploadService *UploadService) getPolicy(bucketType enum.BucketType) (uploadPolicy, error) {
+	policy, ok := uploadService.policies[bucketType]
+	if !ok {
+		var validationErrors exception.ValidationErrors
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.UnsupportedFileType)
+		return uploadPolicy{}, validationErrors
+	}
+	return policy, nil
+}
+
+func (uploadService *UploadService) validateContent(policy uploadPolicy, data []byte) (string, error) {
+	var validationErrors exception.ValidationErrors
+	if int64(len(data)) > policy.maxSize {
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.FileTooLarge)
+		return "", validationErrors
+	}
+
+	contentType := http.DetectContentType(data)
+	if !slices.Contains(policy.contentTypes, contentType) {
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.UnsupportedFileType)
+		return "", validationErrors
+	}
+
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		if !uploadService.checkImageDimensions(data) {
+			validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.InvalidDimensions)
+			return "", validationErrors
+		}
+	}
+
+	clean, err := uploadService.malwareScanner.Scan(data)
+	if err != nil {
+		return "", err
+	}
+	if !clean {
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.MalwareDetected)
+		return "", validationErrors
+	}
+	return contentType, nil
+}
+
+// ValidateUpload checks the file against the bucket's policy and returns a random
+// object name to store it under. The content type is detected from the file's
+// bytes; the client-supplied name and Content-Type header are ignored.
+func (uploadService *UploadService) ValidateUpload(bucketType enum.BucketType, file *multipart.FileHeader) (string, error) {
+	policy, err := uploadService.getPolicy(bucketType)
+	if err != nil {
+		return "", err
+	}
+
+	if file.Size > policy.maxSize {
+		var validationErrors exception.ValidationErrors
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.FileTooLarge)
+		return "", validationErrors
+	}
+
+	fileReader, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer fileReader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(fileReader, policy.maxSize+1))
+	if err != nil {
+		return "", err
+	}
+
+	contentType, err := uploadService.validateContent(policy, data)
+	if err != nil {
+		return "", err
+	}
+	return uploadService.generateObjectName(contentType)
+}
+
+// ValidateDirectUpload checks the declared type and size of a file the client is
+// about to upload straight to storage. The bytes are checked later by
+// VerifyPendingUpload.
+func (uploadService *UploadService) ValidateDirectUpload(bucketType enum.BucketType, contentType string, size int64) (string, error) {
+	policy, err := uploadService.getPolicy(bucketType)
+	if err != nil {
+		return "", err
+	}
+
+	var validationErrors exception.ValidationErrors
+	if !slices.Contains(policy.contentTypes, contentType) {
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.UnsupportedFileType)
+		return "", validationErrors
+	}
+	if size > policy.maxSize {
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.FileTooLarge)
+		return "", validationErrors
+	}
+	return uploadService.generateObjectName(contentType)
+}
+
+func (uploadService *UploadService) CreateUploadURL(pendingUpload *entity.PendingUpload) (string, error) {
+	expiration := time.Duration(uploadService.uploadConfig.DirectUploadExpiryMinutes) * time.Minute
+	uploadURL, err := uploadService.s3Storage.GetPresignedUploadURL(pendingUpload.BucketType, pendingUpload.Key, pendingUpload.ContentType, pendingUpload.Size, expiration)
+	if err != nil {
+		return "", err
+	}
+
+	pendingUpload.ExpiresAt = time.Now().Add(expiration)
+	if err := uploadService.pendingUploadRepository.CreatePendingUpload(uploadService.db, pendingUpload); err != nil {
+		return "", err
+	}
+	return uploadURL, nil
+}
+
+func (uploadService *UploadService) GetPendingUpload(uploadID uint) (*entity.PendingUpload, error) {
+	pendingUpload, err := uploadService.pendingUploadRepository.FindPendingUploadByID(uploadService.db, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if pendingUpload == nil {
+		return nil, exception.NotFoundError{Item: uploadService.constants.Field.Upload}
+	}
+	return pendingUpload, nil
+}
+
+// VerifyPendingUpload checks that the object was uploaded and runs the same
+// content checks as a regular upload. A rejected object is deleted right away.
+func (uploadService *UploadService) VerifyPendingUpload(pendingUpload *entity.PendingUpload) error {
+	var validationErrors exception.ValidationErrors
+	objectInfo, err := uploadService.s3Storage.HeadObject(pendingUpload.BucketType, pendingUpload.Key)
+	if err != nil {
+		return err
+	}
+	if objectInfo == nil {
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.NotExist)
+		return validationErrors
+	}
+
+	if objectInfo.Size > pendingUpload.Size {
+		if err := uploadService.s3Storage.DeleteObject(pendingUpload.BucketType, pendingUpload.Key); err != nil {
+			return err
+		}
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.FileTooLarge)
+		return validationErrors
+	}
+
+	data, err := uploadService.s3Storage.GetObject(pendingUpload.BucketType, pendingUpload.Key)
+	if err != nil {
+		return err
+	}
+
+	policy, err := uploadService.getPolicy(pendingUpload.BucketType)
+	if err != nil {
+		return err
+	}
+	contentType, err := uploadService.validateContent(policy, data)
+	if err == nil && contentType != pendingUpload.ContentType {
+		validationErrors.Add(uploadService.constants.Field.File, uploadService.constants.Tag.UnsupportedFileType)
+		err = validationErrors
+	}
+	if err != nil {
+		if deleteErr := uploadService.s3Storage.DeleteObject(pendingUpload.BucketType, pendingUpload.Key); deleteErr != nil {
+			return deleteErr
+		}
+		return err
+	}
+	return nil
+}
+
+// CleanupExpiredUploads removes uploads that were never confirmed, along with
+// any object the client managed to store for them. The row is deleted first and
+// only if it is still expired, so an upload confirmed in the meantime is left alone.
+func (uploadService *UploadService) CleanupExpiredUploads() error {
+	paginationModifier := postgresImpl.NewPaginationModifier(100, 0)
+	for {
+		now := time.Now()
+		pendingUploads, err := uploadService.pendingUploadRepository.FindExpiredPendingUploads(uploadService.db, now, paginationModifier)
+		if err != nil {
+			return err
+		}
+		if len(pendingUploads) == 0 {
+			return nil
+		}
+
+		for _, pendingUpload := range pendingUploads {
+			err := uploadService.db.WithTransaction(func(tx database.Database) error {
+				deleted, err := uploadService.pendingUploadRepository.DeleteExpiredPendingUpload(tx, pendingUpload.ID, now)
+				if err != nil || !deleted {
+					return err
+				}
+				objectInfo, err := uploadService.s3Storage.HeadObject(pendingUpload.BucketType, pendingUpload.Key)
+				if err != nil {
+					return err
+				}
+				if objectInfo == nil {
+					return nil
+				}
+				return uploadService.s3Storage.DeleteObject(pendingUpload.BucketType, pendingUpload.Key)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
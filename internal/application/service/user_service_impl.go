@@ -14,7 +14,6 @@ import (
 	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/repository/redis"
-	"github.com/CosmeticsShiraz/Backend/internal/domain/s3"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
 	postgresImpl "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
 	"golang.org/x/crypto/bcrypt"
@@ -22,38 +21,47 @@ import (
 
 type UserService struct {
 	constants           *bootstrap.Constants
+	referralConfig      *bootstrap.Referral
 	otpService          usecase.OTPService
 	jwtService          usecase.JWTService
 	smsService          communication.SMSService
 	emailService        communication.EmailService
-	s3Storage           s3.S3Storage
+	imageService        usecase.ImageService
+	uploadService       usecase.UploadService
 	userRepository      postgres.UserRepository
 	userCacheRepository redis.UserCacheRepository
+	referralRepository  postgres.ReferralRepository
 	db                  database.Database
 }
 
 type UserServiceDeps struct {
 	Constants           *bootstrap.Constants
+	ReferralConfig      *bootstrap.Referral
 	OTPService          usecase.OTPService
 	JWTService          usecase.JWTService
 	SMSService          communication.SMSService
 	EmailService        communication.EmailService
-	S3Storage           s3.S3Storage
+	ImageService        usecase.ImageService
+	UploadService       usecase.UploadService
 	UserRepository      postgres.UserRepository
 	UserCacheRepository redis.UserCacheRepository
+	ReferralRepository  postgres.ReferralRepository
 	DB                  database.Database
 }
 
 func NewUserService(deps UserServiceDeps) *UserService {
 	return &UserService{
 		constants:           deps.Constants,
+		referralConfig:      deps.ReferralConfig,
 		otpService:          deps.OTPService,
 		jwtService:          deps.JWTService,
 		smsService:          deps.SMSService,
 		emailService:        deps.EmailService,
-		s3Storage:           deps.S3Storage,
+		imageService:        deps.ImageService,
+		uploadService:       deps.UploadService,
 		userRepository:      deps.UserRepository,
 		userCacheRepository: deps.UserCacheRepository,
+		referralRepository:  deps.ReferralRepository,
 		db:                  deps.DB,
 	}
 }
@@ -190,6 +198,19 @@ func (userService *UserService) GetUserByID(userID uint) (*entity.User, error) {
 	return user, nil
 }
 
+// GetUsersByIDs loads several users in one query, keyed by their ID.
+func (userService *UserService) GetUsersByIDs(userIDs []uint) (map[uint]*entity.User, error) {
+	users, err := userService.userRepository.FindUsersByIDs(userService.db, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	usersByID := make(map[uint]*entity.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+	return usersByID, nil
+}
+
 func (userService *UserService) FindActiveUserByPhone(phone string) (*entity.User, error) {
 	user, err := userService.userRepository.FindUserByPhone(userService.db, phone)
 	if err != nil {
@@ -213,14 +234,43 @@ func (userService *UserService) GetUserCredential(userID uint) (userdto.Credenti
 	if err != nil {
 		return userdto.CredentialResponse{}, err
 	}
+	return userService.mapToCredentialResponse(user)
+}
+
+// GetUserCredentials loads the credentials of several users in one query, keyed
+// by user ID. Missing users are reported as not found.
+func (userService *UserService) GetUserCredentials(userIDs []uint) (map[uint]userdto.CredentialResponse, error) {
+	if len(userIDs) == 0 {
+		return map[uint]userdto.CredentialResponse{}, nil
+	}
+	users, err := userService.GetUsersByIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
 
-	profilePic := ""
-	if user.ProfilePicPath != "" {
-		profilePic, err = userService.s3Storage.GetPresignedURL(enum.ProfilePic, user.ProfilePicPath, 8*time.Hour)
+	credentials := make(map[uint]userdto.CredentialResponse, len(users))
+	for _, userID := range userIDs {
+		if _, ok := credentials[userID]; ok {
+			continue
+		}
+		user, ok := users[userID]
+		if !ok {
+			notFoundError := exception.NotFoundError{Item: userService.constants.Field.User}
+			return nil, notFoundError
+		}
+		credentials[userID], err = userService.mapToCredentialResponse(user)
 		if err != nil {
-			return userdto.CredentialResponse{}, err
+			return nil, err
 		}
 	}
+	return credentials, nil
+}
+
+func (userService *UserService) mapToCredentialResponse(user *entity.User) (userdto.CredentialResponse, error) {
+	profilePic, err := userService.imageService.GetImageURLs(enum.ProfilePic, user.ProfilePicPath, 8*time.Hour)
+	if err != nil {
+		return userdto.CredentialResponse{}, err
+	}
 	return userdto.CredentialResponse{
 		ID:         user.ID,
 		FirstName:  user.FirstName,
@@ -248,12 +298,9 @@ func (userService *UserService) GetUsersByStatus(request userdto.GetUsersListReq
 	}
 	usersResponse := make([]userdto.CredentialResponse, len(users))
 	for i, user := range users {
-		profilePic := ""
-		if user.ProfilePicPath != "" {
-			profilePic, err = userService.s3Storage.GetPresignedURL(enum.ProfilePic, user.ProfilePicPath, 8*time.Hour)
-			if err != nil {
-				return nil, err
-			}
+		profilePic, err := userService.imageService.GetImageURLs(enum.ProfilePic, user.ProfilePicPath, 8*time.Hour)
+		if err != nil {
+			return nil, err
 		}
 		usersResponse[i] = userdto.CredentialResponse{
 			ID:         user.ID,
@@ -333,6 +380,58 @@ func (userService *UserService) UnbanUser(userID uint) error {
 	return nil
 }
 
+func (userService *UserService) findReferrer(referralCode string) (*entity.User, error) {
+	referrer, err := userService.userRepository.FindUserByReferralCode(userService.db, referralCode)
+	if err != nil {
+		return nil, err
+	}
+	if referrer == nil || referrer.Status == enum.UserStatusBlock {
+		var validationErrors exception.ValidationErrors
+		validationErrors.Add(userService.constants.Field.ReferralCode, userService.constants.Tag.NotExist)
+		return nil, validationErrors
+	}
+	return referrer, nil
+}
+
+// createReferral rejects referrals from a device that was already referred. The
+// device ID is optional client input, so referrals without one, or from an
+// address that registered too many referrals lately, wait for an admin review
+// instead of being rewarded automatically.
+func (userService *UserService) createReferral(db database.Database, referrer, referee *entity.User, deviceID, clientIP string) error {
+	referral := &entity.Referral{
+		ReferrerID:     referrer.ID,
+		RefereeID:      referee.ID,
+		DeviceID:       deviceID,
+		RegistrationIP: clientIP,
+		Status:         enum.ReferralStatusPending,
+	}
+
+	if deviceID == "" {
+		referral.Status = enum.ReferralStatusUnderReview
+	} else {
+		count, err := userService.referralRepository.CountReferralsByDeviceID(db, deviceID)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			referral.Status = enum.ReferralStatusRejected
+		}
+	}
+
+	if referral.Status == enum.ReferralStatusPending {
+		since := time.Now().Add(-time.Duration(userService.referralConfig.IPWindowHours) * time.Hour)
+		count, err := userService.referralRepository.CountReferralsByIP(db, clientIP, since)
+		if err != nil {
+			return err
+		}
+		if count >= int64(userService.referralConfig.MaxPerIP) {
+			referral.Status = enum.ReferralStatusUnderReview
+		}
+	}
+
+	return userService.referralRepository.CreateReferral(db, referral)
+}
+
 func (userService *UserService) Register(registerInfo userdto.BasicRegisterRequest) error {
 	err := userService.validateDuplicatePhone(registerInfo.Phone)
 	if err != nil {
@@ -344,6 +443,14 @@ func (userService *UserService) Register(registerInfo userdto.BasicRegisterReque
 		return err
 	}
 
+	var referrer *entity.User
+	if registerInfo.ReferralCode != "" {
+		referrer, err = userService.findReferrer(registerInfo.ReferralCode)
+		if err != nil {
+			return err
+		}
+	}
+
 	hashesPasswordBytes, err := bcrypt.GenerateFromPassword([]byte(registerInfo.Password), 14)
 	if err != nil {
 		return err
@@ -369,6 +476,12 @@ func (userService *UserService) Register(registerInfo userdto.BasicRegisterReque
 			return err
 		}
 
+		if referrer != nil {
+			if err := userService.createReferral(tx, referrer, user, registerInfo.DeviceID, registerInfo.ClientIP); err != nil {
+				return err
+			}
+		}
+
 		otp, expiryMinute, err := userService.otpService.GenerateOTP()
 		if err != nil {
 			return err
@@ -517,6 +630,14 @@ func (userService *UserService) CompleteRegister(completeRegisterInfo userdto.Co
 		return err
 	}
 
+	profilePicName := ""
+	if completeRegisterInfo.ProfilePic != nil {
+		profilePicName, err = userService.uploadService.ValidateUpload(enum.ProfilePic, completeRegisterInfo.ProfilePic)
+		if err != nil {
+			return err
+		}
+	}
+
 	if completeRegisterInfo.Email != "" {
 		userService.enterNewEmail(user.FirstName, user.LastName, completeRegisterInfo.Email, completeRegisterInfo.EmailSubject, completeRegisterInfo.TemplateFile)
 	}
@@ -526,8 +647,10 @@ func (userService *UserService) CompleteRegister(completeRegisterInfo userdto.Co
 
 	err = userService.db.WithTransaction(func(tx database.Database) error {
 		if completeRegisterInfo.ProfilePic != nil {
-			profilePicPath := userService.constants.S3BucketPath.GetUserProfilePath(completeRegisterInfo.UserID, completeRegisterInfo.ProfilePic.Filename)
-			userService.s3Storage.UploadObject(enum.ProfilePic, profilePicPath, completeRegisterInfo.ProfilePic)
+			profilePicPath := userService.constants.S3BucketPath.GetUserProfilePath(completeRegisterInfo.UserID, profilePicName)
+			if err := userService.imageService.UploadImage(enum.ProfilePic, profilePicPath, completeRegisterInfo.ProfilePic); err != nil {
+				return err
+			}
 			user.ProfilePicPath = profilePicPath
 		}
 		err = userService.userRepository.UpdateUser(tx, user)
@@ -603,6 +726,14 @@ func (userService *UserService) UpdateProfile(profileInfo userdto.UpdateProfileR
 		return err
 	}
 
+	profilePicName := ""
+	if profileInfo.ProfilePic != nil {
+		profilePicName, err = userService.uploadService.ValidateUpload(enum.ProfilePic, profileInfo.ProfilePic)
+		if err != nil {
+			return err
+		}
+	}
+
 	if profileInfo.FirstName != nil {
 		user.FirstName = *profileInfo.FirstName
 	}
@@ -623,8 +754,10 @@ func (userService *UserService) UpdateProfile(profileInfo userdto.UpdateProfileR
 
 	oldProfilePicPath := ""
 	if profileInfo.ProfilePic != nil {
-		profilePicPath := userService.constants.S3BucketPath.GetUserProfilePath(profileInfo.UserID, profileInfo.ProfilePic.Filename)
-		userService.s3Storage.UploadObject(enum.ProfilePic, profilePicPath, profileInfo.ProfilePic)
+		profilePicPath := userService.constants.S3BucketPath.GetUserProfilePath(profileInfo.UserID, profilePicName)
+		if err := userService.imageService.UploadImage(enum.ProfilePic, profilePicPath, profileInfo.ProfilePic); err != nil {
+			return err
+		}
 		oldProfilePicPath = user.ProfilePicPath
 		user.ProfilePicPath = profilePicPath
 	}
@@ -634,7 +767,7 @@ func (userService *UserService) UpdateProfile(profileInfo userdto.UpdateProfileR
 		}
 
 		if oldProfilePicPath != "" {
-			if err = userService.s3Storage.DeleteObject(enum.ProfilePic, oldProfilePicPath); err != nil {
+			if err = userService.imageService.DeleteImage(enum.ProfilePic, oldProfilePicPath); err != nil {
 				return err
 			}
 		}
@@ -645,6 +778,35 @@ func (userService *UserService) UpdateProfile(profileInfo userdto.UpdateProfileR
 	return err
 }
 
+func (userService *UserService) GetNotificationPreferences(userID uint) (userdto.NotificationPreferencesResponse, error) {
+	user, err := userService.GetUserByID(userID)
+	if err != nil {
+		return userdto.NotificationPreferencesResponse{}, err
+	}
+
+	return userdto.NotificationPreferencesResponse{
+		CartReminderOptOut: user.CartReminderOptOut,
+		StockAlertOptOut:   user.StockAlertOptOut,
+	}, nil
+}
+
+func (userService *UserService) UpdateNotificationPreferences(request userdto.UpdateNotificationPreferencesRequest) error {
+	user, err := userService.GetUserByID(request.UserID)
+	if err != nil {
+		return err
+	}
+
+	if request.CartReminderOptOut != nil {
+		user.CartReminderOptOut = *request.CartReminderOptOut
+	}
+
+	if request.StockAlertOptOut != nil {
+		user.StockAlertOptOut = *request.StockAlertOptOut
+	}
+
+	return userService.userRepository.UpdateUser(userService.db, user)
+}
+
 func (userService *UserService) GetAllPermissions() ([]userdto.PermissionResponse, error) {
 	permissions, err := userService.userRepository.FindAllPermissions(userService.db)
 	if err != nil {
@@ -794,12 +956,9 @@ func (userService *UserService) GetRoleOwners(roleID uint) ([]userdto.Credential
 
 	userCreds := make([]userdto.CredentialResponse, len(users))
 	for i, user := range users {
-		profilePic := ""
-		if user.ProfilePicPath != "" {
-			profilePic, err = userService.s3Storage.GetPresignedURL(enum.ProfilePic, user.ProfilePicPath, 8*time.Hour)
-			if err != nil {
-				return nil, err
-			}
+		profilePic, err := userService.imageService.GetImageURLs(enum.ProfilePic, user.ProfilePicPath, 8*time.Hour)
+		if err != nil {
+			return nil, err
 		}
 		userCreds[i] = userdto.CredentialResponse{
 			ID:         user.ID,
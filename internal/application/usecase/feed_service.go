@@ -0,0 +1,8 @@
+package usecase
+
+type FeedService interface {
+	GetNewsRSS() ([]byte, error)
+	GetNewsAtom() ([]byte, error)
+	GetSitemap() ([]byte, error)
+	InvalidateFeeds() error
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	giftcarddto "github.com/CosmeticsShiraz/Backend/internal/application/dto/giftcard"
+)
+
+type GiftCardService interface {
+	GetAllGiftCardStatuses() []giftcarddto.GiftCardStatusesResponse
+	IssueGiftCard(request giftcarddto.IssueGiftCardRequest) (giftcarddto.IssuedGiftCardResponse, error)
+	ResendGiftCard(request giftcarddto.ResendGiftCardRequest) error
+	GetGiftCardBalance(request giftcarddto.GetGiftCardBalanceRequest) (giftcarddto.GiftCardBalanceResponse, error)
+	RedeemGiftCard(request giftcarddto.RedeemGiftCardRequest) (uint, error)
+	GetGiftCards(request giftcarddto.GetGiftCardsRequest) ([]giftcarddto.GiftCardResponse, error)
+	GetGiftCardTransactions(request giftcarddto.GetGiftCardTransactionsRequest) ([]giftcarddto.GiftCardTransactionResponse, error)
+	DisableGiftCard(giftCardID uint) error
+}
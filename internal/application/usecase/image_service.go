@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"mime/multipart"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+)
+
+type ImageService interface {
+	UploadImage(bucketType enum.BucketType, key string, file *multipart.FileHeader) error
+	ProcessStoredImage(bucketType enum.BucketType, key string) error
+	GetImageURLs(bucketType enum.BucketType, key string, expiration time.Duration) (map[string]string, error)
+	DeleteImage(bucketType enum.BucketType, key string) error
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	ingredientdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/ingredient"
+)
+
+type IngredientService interface {
+	GetIngredients(request ingredientdto.GetIngredientsRequest) ([]ingredientdto.IngredientResponse, error)
+	GetIngredient(ingredientID uint) (ingredientdto.IngredientResponse, error)
+	CreateIngredient(request ingredientdto.CreateIngredientRequest) (uint, error)
+	UpdateIngredient(request ingredientdto.UpdateIngredientRequest) error
+	DeleteIngredient(ingredientID uint) error
+	GetAllSkinTypes() []ingredientdto.SkinTypesResponse
+	GetAllSkinConcerns() []ingredientdto.SkinConcernsResponse
+	GetSkinProfile(userID uint) (ingredientdto.SkinProfileResponse, error)
+	UpdateSkinProfile(request ingredientdto.UpdateSkinProfileRequest) error
+	CheckIngredients(request ingredientdto.CheckIngredientsRequest) (ingredientdto.SuitabilityResponse, error)
+}
@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+)
+
+type NewsAnalyticsService interface {
+	RecordNewsView(request newsdto.RecordNewsViewRequest) error
+	FlushNewsViews() error
+	GetNewsAnalytics(request newsdto.GetNewsAnalyticsRequest) (newsdto.NewsAnalyticsResponse, error)
+	GetMostReadNews(locale string) ([]newsdto.PublicNewsResponse, error)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+)
+
+type NewsCommentService interface {
+	GetAllCommentStatuses() []newsdto.CommentStatusesResponse
+	GetNewsComments(request newsdto.GetNewsCommentsRequest) ([]newsdto.NewsCommentResponse, error)
+	CreateComment(request newsdto.CreateNewsCommentRequest) (newsdto.CreateNewsCommentResponse, error)
+	FlagComment(request newsdto.FlagNewsCommentRequest) error
+	GetCommentQueue(request newsdto.GetCommentQueueRequest) ([]newsdto.AdminNewsCommentResponse, error)
+	ReviewComment(request newsdto.ReviewNewsCommentRequest) error
+}
@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+)
+
+type NewsPreviewService interface {
+	IsValidPreviewToken(newsID uint, token string) (bool, error)
+	CreateNewsPreview(request newsdto.CreateNewsPreviewRequest) (newsdto.NewsPreviewResponse, error)
+	GetNewsPreviews(newsID uint) ([]newsdto.NewsPreviewResponse, error)
+	RevokeNewsPreview(request newsdto.RevokeNewsPreviewRequest) error
+}
@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+)
+
+type NewsRevisionService interface {
+	GetNewsRevision(revisionID, newsID uint) (*entity.NewsRevision, error)
+	GetNewsRevisions(request newsdto.GetNewsRevisionsRequest) ([]newsdto.NewsRevisionResponse, error)
+	CompareNewsRevisions(request newsdto.CompareNewsRevisionsRequest) (newsdto.NewsRevisionDiffResponse, error)
+}
@@ -2,19 +2,34 @@ package usecase
 
 import (
 	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
 )
 
 type NewsService interface {
 	GetAllNewsStatuses() []newsdto.NewsStatusesResponse
+	PrepareNewsContent(news *entity.News) error
 	GetAdminNews(newsID uint) (newsdto.AdminNewsResponse, error)
-	GetPublicNews(newsID uint) (newsdto.PublicNewsResponse, error)
+	GetPublicNews(request newsdto.GetPublicNewsRequest) (newsdto.PublicNewsResponse, error)
+	GetPublicNewsBySlug(request newsdto.GetPublicNewsBySlugRequest) (newsdto.PublicNewsResponse, error)
 	GetAdminNewsList(request newsdto.GetAdminNewsListRequest) ([]newsdto.AdminNewsResponse, error)
 	GetPublicNewsList(request newsdto.GetPublicNewsListRequest) ([]newsdto.PublicNewsResponse, error)
 	CreateNews(request newsdto.CreateNewsRequest) (uint, error)
 	EditNews(request newsdto.EditNewsRequest) error
 	UpdateNewsStatus(request newsdto.EditNewsStatusRequest) error
+	ScheduleNews(request newsdto.ScheduleNewsRequest) error
+	ProcessScheduledNews() error
 	DeleteNewsStatus(request newsdto.DeleteNewsRequest) error
 	AddNewsMedia(request newsdto.AddNewsMediaRequest) (uint, error)
 	DeleteNewsMedia(request newsdto.AccessMediaRequest) error
 	GetNewsMedia(request newsdto.AccessMediaRequest) (string, error)
+	CreateNewsUpload(request newsdto.CreateNewsUploadRequest) (newsdto.NewsUploadResponse, error)
+	ConfirmNewsUpload(request newsdto.ConfirmNewsUploadRequest) (newsdto.ConfirmNewsUploadResponse, error)
+	GetNewsCategories() ([]newsdto.NewsCategoryResponse, error)
+	CreateNewsCategory(request newsdto.CreateNewsCategoryRequest) (uint, error)
+	UpdateNewsCategory(request newsdto.UpdateNewsCategoryRequest) error
+	DeleteNewsCategory(categoryID uint) error
+	UpdateNewsTaxonomy(request newsdto.UpdateNewsTaxonomyRequest) error
+	GetNewsTagCloud() ([]newsdto.NewsTagCloudResponse, error)
+	GetRelatedNews(request newsdto.GetRelatedNewsRequest) ([]newsdto.PublicNewsResponse, error)
+	RestoreNewsRevision(request newsdto.RestoreNewsRevisionRequest) error
 }
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+)
+
+type NewsTranslationService interface {
+	GetNewsTranslations(newsID uint) ([]newsdto.NewsTranslationResponse, error)
+	SaveNewsTranslation(request newsdto.SaveNewsTranslationRequest) error
+	DeleteNewsTranslation(request newsdto.DeleteNewsTranslationRequest) error
+}
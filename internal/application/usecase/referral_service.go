@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	referraldto "github.com/CosmeticsShiraz/Backend/internal/application/dto/referral"
+)
+
+type ReferralService interface {
+	GetAllReferralStatuses() []referraldto.ReferralStatusesResponse
+	GetUserReferral(userID uint) (referraldto.UserReferralResponse, error)
+	ConvertReferral(refereeID uint) error
+	ReviewReferral(request referraldto.ReviewReferralRequest) error
+	GetReferralReport(request referraldto.GetReferralReportRequest) ([]referraldto.ReferralResponse, error)
+	GetReferralSummary() (referraldto.ReferralSummaryResponse, error)
+}
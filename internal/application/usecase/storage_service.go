@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	storagedto "github.com/CosmeticsShiraz/Backend/internal/application/dto/storage"
+)
+
+type StorageService interface {
+	GetSignedObject(request storagedto.GetSignedObjectRequest) (storagedto.ObjectResponse, error)
+	PutSignedObject(request storagedto.PutSignedObjectRequest) error
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"mime/multipart"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+)
+
+type UploadService interface {
+	ValidateUpload(bucketType enum.BucketType, file *multipart.FileHeader) (string, error)
+	ValidateDirectUpload(bucketType enum.BucketType, contentType string, size int64) (string, error)
+	CreateUploadURL(pendingUpload *entity.PendingUpload) (string, error)
+	GetPendingUpload(uploadID uint) (*entity.PendingUpload, error)
+	VerifyPendingUpload(pendingUpload *entity.PendingUpload) error
+	CleanupExpiredUploads() error
+}
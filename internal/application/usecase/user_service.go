@@ -9,7 +9,9 @@ import (
 type UserService interface {
 	IsUserActive(userID uint) error
 	GetUserByID(userID uint) (*entity.User, error)
+	GetUsersByIDs(userIDs []uint) (map[uint]*entity.User, error)
 	GetUserCredential(userID uint) (userdto.CredentialResponse, error)
+	GetUserCredentials(userIDs []uint) (map[uint]userdto.CredentialResponse, error)
 	GetUsersByPermission(permissionTypes []enum.PermissionType) ([]*entity.User, error)
 	GetUsersByStatus(request userdto.GetUsersListRequest) ([]userdto.CredentialResponse, error)
 	BanUser(userID uint) error
@@ -24,6 +26,8 @@ type UserService interface {
 	ResetPassword(resetPassInfo userdto.ResetPasswordRequest) error
 	FindActiveUserByPhone(phone string) (*entity.User, error)
 	UpdateProfile(profileInfo userdto.UpdateProfileRequest) error
+	GetNotificationPreferences(userID uint) (userdto.NotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(request userdto.UpdateNotificationPreferencesRequest) error
 	GetAllPermissions() ([]userdto.PermissionResponse, error)
 	GetAllRoles() ([]userdto.RoleResponse, error)
 	CreateRole(newRoleRequest userdto.NewRoleRequest) error
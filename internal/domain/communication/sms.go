@@ -2,4 +2,5 @@ package communication
 
 type SMSService interface {
 	SendOTP(receptor string, token string) error
+	SendGiftCard(receptor string, code string, value string) error
 }
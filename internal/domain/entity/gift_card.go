@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type GiftCard struct {
+	database.Model
+	Code           string              `gorm:"type:varchar(32);uniqueIndex;not null"`
+	Value          uint                `gorm:"not null"`
+	Balance        uint                `gorm:"not null"`
+	ExpiresAt      time.Time           `gorm:"not null;index"`
+	Status         enum.GiftCardStatus `gorm:"index"`
+	IssuerID       uint                `gorm:"not null;index"`
+	Issuer         User                `gorm:"foreignKey:IssuerID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	RecipientName  string              `gorm:"type:varchar(100)"`
+	RecipientPhone string              `gorm:"type:varchar(20)"`
+	RecipientEmail string              `gorm:"type:varchar(100)"`
+	DeliveredAt    *time.Time
+	DeliveryError  string `gorm:"type:varchar(255)"`
+	Transactions   []GiftCardTransaction
+}
+
+type GiftCardTransaction struct {
+	database.Model
+	GiftCardID   uint                         `gorm:"not null;index"`
+	Type         enum.GiftCardTransactionType `gorm:"not null"`
+	Amount       uint                         `gorm:"not null"`
+	BalanceAfter uint                         `gorm:"not null"`
+	Reference    string                       `gorm:"type:varchar(100)"`
+	ActorID      *uint                        `gorm:"index"`
+}
@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type ImageRendition struct {
+	database.Model
+	BucketType  enum.BucketType `gorm:"not null;index:idx_rendition_original"`
+	OriginalKey string          `gorm:"type:varchar(255);not null;index:idx_rendition_original"`
+	Name        string          `gorm:"type:varchar(20);not null"`
+	Key         string          `gorm:"type:varchar(255);not null"`
+	Width       int
+	Height      int
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type Ingredient struct {
+	database.Model
+	INCIName          string `gorm:"type:varchar(150);not null;uniqueIndex:idx_ingredients_inci_name_lower,expression:LOWER(inci_name)"`
+	PersianName       string `gorm:"type:varchar(150);index"`
+	IsParaben         bool   `gorm:"default:false"`
+	IsFragrance       bool   `gorm:"default:false"`
+	ComedogenicRating uint   `gorm:"default:0"`
+}
+
+type UserSkinConcern struct {
+	UserID  uint             `gorm:"primaryKey"`
+	Concern enum.SkinConcern `gorm:"primaryKey"`
+}
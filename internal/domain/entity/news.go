@@ -1,19 +1,30 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
 )
 
 type News struct {
 	database.Model
-	Title       string  `json:"title"`
-	Content     string  `json:"content_html"`
-	Description string  `json:"description"`
-	AuthorID    uint    `gorm:"not null;index"`
-	Author      User    `gorm:"foreignKey:AuthorID"`
-	CoverImage  string  `gorm:"type:text;default:null"`
-	Media       []Media `gorm:"polymorphic:Owner;polymorphicValue:news"`
-	Likes       []Like  `gorm:"polymorphic:Owner;polymorphicValue:news"`
-	Status      enum.NewsStatus
+	Title           string         `json:"title"`
+	Slug            string         `gorm:"uniqueIndex;default:null"`
+	Content         string         `json:"content_html"`
+	Description     string         `json:"description"`
+	AuthorID        uint           `gorm:"not null;index"`
+	Author          User           `gorm:"foreignKey:AuthorID"`
+	CoverImage      string         `gorm:"type:text;default:null"`
+	Media           []Media        `gorm:"polymorphic:Owner;polymorphicValue:news"`
+	Likes           []Like         `gorm:"polymorphic:Owner;polymorphicValue:news"`
+	Categories      []NewsCategory `gorm:"many2many:news_category_assignments;constraint:OnDelete:CASCADE;"`
+	Tags            []NewsTag      `gorm:"many2many:news_tag_assignments;constraint:OnDelete:CASCADE;"`
+	Status          enum.NewsStatus
+	PublishAt       *time.Time `gorm:"index"`
+	UnpublishAt     *time.Time `gorm:"index"`
+	PublishedAt     *time.Time `gorm:"index"`
+	MetaTitle       string     `gorm:"type:text;default:null"`
+	MetaDescription string     `gorm:"type:text;default:null"`
+	OGImage         string     `gorm:"type:text;default:null"`
 }
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type NewsComment struct {
+	database.Model
+	NewsID    uint               `gorm:"not null;index"`
+	News      News               `gorm:"foreignKey:NewsID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	UserID    uint               `gorm:"not null;index"`
+	User      User               `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	ParentID  *uint              `gorm:"index"`
+	Content   string             `gorm:"type:text;not null"`
+	Status    enum.CommentStatus `gorm:"index"`
+	FlagCount uint               `gorm:"default:0"`
+}
+
+type NewsCommentFlag struct {
+	database.Model
+	CommentID uint   `gorm:"not null;uniqueIndex:idx_comment_flag_user"`
+	UserID    uint   `gorm:"not null;uniqueIndex:idx_comment_flag_user"`
+	Reason    string `gorm:"type:varchar(255)"`
+}
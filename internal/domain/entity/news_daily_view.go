@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type NewsDailyView struct {
+	database.Model
+	NewsID uint      `gorm:"not null;uniqueIndex:idx_news_daily_view"`
+	News   News      `gorm:"foreignKey:NewsID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Day    time.Time `gorm:"type:date;not null;uniqueIndex:idx_news_daily_view"`
+	Views  int64     `gorm:"not null;default:0"`
+}
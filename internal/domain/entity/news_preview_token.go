@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type NewsPreviewToken struct {
+	database.Model
+	NewsID      uint      `gorm:"not null;index"`
+	News        News      `gorm:"foreignKey:NewsID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CreatedByID uint      `gorm:"not null"`
+	CreatedBy   User      `gorm:"foreignKey:CreatedByID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	Nonce       string    `gorm:"type:varchar(32);not null"`
+	ExpiresAt   time.Time `gorm:"not null;index"`
+	RevokedAt   *time.Time
+}
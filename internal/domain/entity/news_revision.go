@@ -0,0 +1,15 @@
+package entity
+
+import "github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+
+type NewsRevision struct {
+	database.Model
+	NewsID       uint   `gorm:"not null;uniqueIndex:idx_news_revision_number"`
+	Number       uint   `gorm:"not null;uniqueIndex:idx_news_revision_number"`
+	EditorID     uint   `gorm:"not null;index"`
+	Editor       User   `gorm:"foreignKey:EditorID"`
+	Title        string `gorm:"not null"`
+	Content      string `gorm:"type:text"`
+	Description  string `gorm:"type:text"`
+	RestoredFrom *uint
+}
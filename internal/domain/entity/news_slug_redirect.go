@@ -0,0 +1,9 @@
+package entity
+
+import "github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+
+type NewsSlugRedirect struct {
+	database.Model
+	NewsID uint   `gorm:"not null;index"`
+	Slug   string `gorm:"not null;uniqueIndex"`
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+
+type NewsCategory struct {
+	database.Model
+	Name string `gorm:"type:varchar(100);uniqueIndex;not null"`
+	Slug string `gorm:"type:varchar(100);uniqueIndex;not null"`
+}
+
+type NewsTag struct {
+	database.Model
+	Name string `gorm:"type:varchar(100);not null"`
+	Slug string `gorm:"type:varchar(100);uniqueIndex;not null"`
+}
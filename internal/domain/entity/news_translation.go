@@ -0,0 +1,14 @@
+package entity
+
+import "github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+
+type NewsTranslation struct {
+	database.Model
+	NewsID      uint   `gorm:"not null;uniqueIndex:idx_news_translation_locale"`
+	News        News   `gorm:"foreignKey:NewsID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Locale      string `gorm:"type:varchar(10);not null;uniqueIndex:idx_news_translation_locale"`
+	Title       string `gorm:"not null"`
+	Description string
+	Content     string
+	EditorID    uint `gorm:"not null"`
+}
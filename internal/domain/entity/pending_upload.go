@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type PendingUpload struct {
+	database.Model
+	BucketType  enum.BucketType    `gorm:"not null"`
+	Key         string             `gorm:"type:varchar(255);not null;uniqueIndex"`
+	ContentType string             `gorm:"type:varchar(100);not null"`
+	Size        int64              `gorm:"not null"`
+	Purpose     enum.UploadPurpose `gorm:"not null"`
+	OwnerID     uint               `gorm:"not null;index"`
+	OwnerType   string             `gorm:"type:varchar(50);not null"`
+	UploaderID  uint               `gorm:"not null"`
+	Uploader    User               `gorm:"foreignKey:UploaderID"`
+	ExpiresAt   time.Time          `gorm:"not null;index"`
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type Referral struct {
+	database.Model
+	ReferrerID     uint                `gorm:"not null;index"`
+	Referrer       User                `gorm:"foreignKey:ReferrerID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	RefereeID      uint                `gorm:"not null;uniqueIndex"`
+	Referee        User                `gorm:"foreignKey:RefereeID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	DeviceID       string              `gorm:"type:varchar(100);index"`
+	RegistrationIP string              `gorm:"type:varchar(45);index"`
+	Status         enum.ReferralStatus `gorm:"index"`
+	ReferrerReward uint                `gorm:"default:0"`
+	RefereeReward  uint                `gorm:"default:0"`
+	ConvertedAt    *time.Time
+}
@@ -7,16 +7,23 @@ import (
 
 type User struct {
 	database.Model
-	FirstName      string          `gorm:"type:varchar(50);index:idx_user_name"`
-	LastName       string          `gorm:"type:varchar(50);index:idx_user_name"`
-	Phone          string          `gorm:"type:varchar(20);uniqueIndex"`
-	PhoneVerified  bool            `gorm:"default:false"`
-	Password       string          `gorm:"type:varchar(255);not null"`
-	Email          string          `gorm:"type:varchar(100);Index"`
-	EmailVerified  bool            `gorm:"default:false"`
-	NationalCode   string          `gorm:"type:varchar(20);Index"`
-	ProfilePicPath string          `gorm:"type:varchar(255);default:null"`
-	Status         enum.UserStatus `gorm:"index"`
-	Addresses      []Address       `gorm:"polymorphic:Owner;polymorphicValue:users"`
-	Roles          []Role          `gorm:"many2many:user_roles;constraint:OnDelete:CASCADE;"`
+	FirstName          string          `gorm:"type:varchar(50);index:idx_user_name"`
+	LastName           string          `gorm:"type:varchar(50);index:idx_user_name"`
+	Phone              string          `gorm:"type:varchar(20);uniqueIndex"`
+	PhoneVerified      bool            `gorm:"default:false"`
+	Password           string          `gorm:"type:varchar(255);not null"`
+	Email              string          `gorm:"type:varchar(100);Index"`
+	EmailVerified      bool            `gorm:"default:false"`
+	NationalCode       string          `gorm:"type:varchar(20);Index"`
+	ProfilePicPath     string          `gorm:"type:varchar(255);default:null"`
+	Status             enum.UserStatus `gorm:"index"`
+	ReferralCode       *string         `gorm:"type:varchar(20);uniqueIndex"`
+	RewardPoints       uint            `gorm:"default:0"`
+	CartReminderOptOut bool            `gorm:"default:false"`
+	StockAlertOptOut   bool            `gorm:"default:false"`
+	SkinType           *enum.SkinType
+	Allergies          []Ingredient      `gorm:"many2many:user_allergies;constraint:OnDelete:CASCADE;"`
+	SkinConcerns       []UserSkinConcern `gorm:"constraint:OnDelete:CASCADE;"`
+	Addresses          []Address         `gorm:"polymorphic:Owner;polymorphicValue:users"`
+	Roles              []Role            `gorm:"many2many:user_roles;constraint:OnDelete:CASCADE;"`
 }
@@ -0,0 +1,33 @@
+package enum
+
+type CommentStatus uint
+
+const (
+	CommentStatusPending CommentStatus = iota + 1
+	CommentStatusApproved
+	CommentStatusRejected
+	CommentStatusAll
+)
+
+func (status CommentStatus) String() string {
+	switch status {
+	case CommentStatusPending:
+		return "در انتظار بررسی"
+	case CommentStatusApproved:
+		return "تایید شده"
+	case CommentStatusRejected:
+		return "رد شده"
+	case CommentStatusAll:
+		return "همه"
+	}
+	return ""
+}
+
+func GetAllCommentStatuses() []CommentStatus {
+	return []CommentStatus{
+		CommentStatusPending,
+		CommentStatusApproved,
+		CommentStatusRejected,
+		CommentStatusAll,
+	}
+}
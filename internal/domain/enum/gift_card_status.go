@@ -0,0 +1,29 @@
+package enum
+
+type GiftCardStatus uint
+
+const (
+	GiftCardStatusActive GiftCardStatus = iota + 1
+	GiftCardStatusDisabled
+	GiftCardStatusAll
+)
+
+func (status GiftCardStatus) String() string {
+	switch status {
+	case GiftCardStatusActive:
+		return "فعال"
+	case GiftCardStatusDisabled:
+		return "غیرفعال"
+	case GiftCardStatusAll:
+		return "همه"
+	}
+	return ""
+}
+
+func GetAllGiftCardStatuses() []GiftCardStatus {
+	return []GiftCardStatus{
+		GiftCardStatusActive,
+		GiftCardStatusDisabled,
+		GiftCardStatusAll,
+	}
+}
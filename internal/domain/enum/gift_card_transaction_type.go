@@ -0,0 +1,25 @@
+package enum
+
+type GiftCardTransactionType uint
+
+const (
+	GiftCardTransactionIssue GiftCardTransactionType = iota + 1
+	GiftCardTransactionRedeem
+)
+
+func (transactionType GiftCardTransactionType) String() string {
+	switch transactionType {
+	case GiftCardTransactionIssue:
+		return "صدور"
+	case GiftCardTransactionRedeem:
+		return "استفاده"
+	}
+	return ""
+}
+
+func GetAllGiftCardTransactionTypes() []GiftCardTransactionType {
+	return []GiftCardTransactionType{
+		GiftCardTransactionIssue,
+		GiftCardTransactionRedeem,
+	}
+}
@@ -0,0 +1,21 @@
+package enum
+
+type IngredientWarning uint
+
+const (
+	IngredientWarningAllergen IngredientWarning = iota + 1
+	IngredientWarningFragrance
+	IngredientWarningComedogenic
+)
+
+func (warning IngredientWarning) String() string {
+	switch warning {
+	case IngredientWarningAllergen:
+		return "حساسیت زا برای شما"
+	case IngredientWarningFragrance:
+		return "دارای عطر، نامناسب برای پوست حساس"
+	case IngredientWarningComedogenic:
+		return "احتمال مسدود کردن منافذ پوست"
+	}
+	return ""
+}
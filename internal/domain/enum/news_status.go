@@ -6,6 +6,7 @@ const (
 	NewsStatusActive NewsStatus = iota + 1
 	NewsStatusDraft
 	NewsStatusAll
+	NewsStatusScheduled
 )
 
 func (status NewsStatus) String() string {
@@ -16,6 +17,8 @@ func (status NewsStatus) String() string {
 		return "پیش نویس"
 	case NewsStatusAll:
 		return "همه"
+	case NewsStatusScheduled:
+		return "زمان بندی شده"
 	}
 	return ""
 }
@@ -24,6 +27,7 @@ func GetAllNewsStatus() []NewsStatus {
 	return []NewsStatus{
 		NewsStatusActive,
 		NewsStatusDraft,
+		NewsStatusScheduled,
 		NewsStatusAll,
 	}
 }
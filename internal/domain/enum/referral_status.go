@@ -0,0 +1,37 @@
+package enum
+
+type ReferralStatus uint
+
+const (
+	ReferralStatusPending ReferralStatus = iota + 1
+	ReferralStatusConverted
+	ReferralStatusRejected
+	ReferralStatusAll
+	ReferralStatusUnderReview
+)
+
+func (status ReferralStatus) String() string {
+	switch status {
+	case ReferralStatusPending:
+		return "در انتظار اولین خرید"
+	case ReferralStatusConverted:
+		return "موفق"
+	case ReferralStatusRejected:
+		return "رد شده"
+	case ReferralStatusAll:
+		return "همه"
+	case ReferralStatusUnderReview:
+		return "در انتظار بررسی"
+	}
+	return ""
+}
+
+func GetAllReferralStatuses() []ReferralStatus {
+	return []ReferralStatus{
+		ReferralStatusPending,
+		ReferralStatusConverted,
+		ReferralStatusRejected,
+		ReferralStatusAll,
+		ReferralStatusUnderReview,
+	}
+}
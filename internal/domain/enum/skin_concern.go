@@ -0,0 +1,41 @@
+package enum
+
+type SkinConcern uint
+
+const (
+	SkinConcernAcne SkinConcern = iota + 1
+	SkinConcernAging
+	SkinConcernPigmentation
+	SkinConcernDryness
+	SkinConcernRedness
+	SkinConcernPores
+)
+
+func (concern SkinConcern) String() string {
+	switch concern {
+	case SkinConcernAcne:
+		return "آکنه"
+	case SkinConcernAging:
+		return "پیری پوست"
+	case SkinConcernPigmentation:
+		return "لک و تیرگی"
+	case SkinConcernDryness:
+		return "خشکی"
+	case SkinConcernRedness:
+		return "قرمزی"
+	case SkinConcernPores:
+		return "منافذ باز"
+	}
+	return ""
+}
+
+func GetAllSkinConcerns() []SkinConcern {
+	return []SkinConcern{
+		SkinConcernAcne,
+		SkinConcernAging,
+		SkinConcernPigmentation,
+		SkinConcernDryness,
+		SkinConcernRedness,
+		SkinConcernPores,
+	}
+}
@@ -0,0 +1,37 @@
+package enum
+
+type SkinType uint
+
+const (
+	SkinTypeNormal SkinType = iota + 1
+	SkinTypeDry
+	SkinTypeOily
+	SkinTypeCombination
+	SkinTypeSensitive
+)
+
+func (skinType SkinType) String() string {
+	switch skinType {
+	case SkinTypeNormal:
+		return "نرمال"
+	case SkinTypeDry:
+		return "خشک"
+	case SkinTypeOily:
+		return "چرب"
+	case SkinTypeCombination:
+		return "مختلط"
+	case SkinTypeSensitive:
+		return "حساس"
+	}
+	return ""
+}
+
+func GetAllSkinTypes() []SkinType {
+	return []SkinType{
+		SkinTypeNormal,
+		SkinTypeDry,
+		SkinTypeOily,
+		SkinTypeCombination,
+		SkinTypeSensitive,
+	}
+}
@@ -0,0 +1,25 @@
+package enum
+
+type UploadPurpose uint
+
+const (
+	UploadPurposeMedia UploadPurpose = iota + 1
+	UploadPurposeCoverImage
+)
+
+func (purpose UploadPurpose) String() string {
+	switch purpose {
+	case UploadPurposeMedia:
+		return "رسانه"
+	case UploadPurposeCoverImage:
+		return "تصویر کاور"
+	}
+	return ""
+}
+
+func GetAllUploadPurposes() []UploadPurpose {
+	return []UploadPurpose{
+		UploadPurposeMedia,
+		UploadPurposeCoverImage,
+	}
+}
@@ -0,0 +1,18 @@
+package exception
+
+import "fmt"
+
+// DeliveryError reports that an item could not be sent to its recipient over
+// SMS or email. The cause is kept for logging and never shown to the client.
+type DeliveryError struct {
+	Item string
+	Err  error
+}
+
+func (de DeliveryError) Error() string {
+	return fmt.Sprintf("item: %s delivery failed: %v", de.Item, de.Err)
+}
+
+func (de DeliveryError) Unwrap() error {
+	return de.Err
+}
@@ -0,0 +1,22 @@
+package imaging
+
+type Image struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// ProcessedImage holds the renditions of a sanitized original. The original and
+// every rendition also come as WebP, keyed by the same rendition name.
+type ProcessedImage struct {
+	OriginalWebP Image
+	Renditions   map[string]Image
+	WebP         map[string]Image
+}
+
+type ImageProcessor interface {
+	IsSupported(data []byte) bool
+	Sanitize(data []byte, maxDimension int) (Image, error)
+	Process(data []byte, renditionWidths map[string]int) (ProcessedImage, error)
+}
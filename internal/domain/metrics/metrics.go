@@ -3,4 +3,5 @@ package metrics
 type MetricsClient interface {
 	IncHTTPRequest(method, route, status string)
 	ObserveHTTPRequestDuration(method, route string, duration float64)
-}
\ No newline at end of file
+	IncBusinessEvent(domain, event string)
+}
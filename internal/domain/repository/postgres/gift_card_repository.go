@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type GiftCardRepository interface {
+	CreateGiftCard(db database.Database, giftCard *entity.GiftCard) error
+	UpdateGiftCard(db database.Database, giftCard *entity.GiftCard) error
+	UpdateGiftCardDelivery(db database.Database, giftCardID uint, deliveredAt *time.Time, deliveryError string) error
+	FindGiftCardByID(db database.Database, giftCardID uint) (*entity.GiftCard, error)
+	FindGiftCardByCode(db database.Database, code string) (*entity.GiftCard, error)
+	FindGiftCardByCodeForUpdate(db database.Database, code string) (*entity.GiftCard, error)
+	FindGiftCardsByStatus(db database.Database, statuses []enum.GiftCardStatus, opts ...QueryModifier) ([]*entity.GiftCard, error)
+	CreateGiftCardTransaction(db database.Database, transaction *entity.GiftCardTransaction) error
+	FindGiftCardTransactions(db database.Database, giftCardID uint, opts ...QueryModifier) ([]*entity.GiftCardTransaction, error)
+}
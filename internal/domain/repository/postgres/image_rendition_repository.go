@@ -0,0 +1,13 @@
+package postgres
+
+import (
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type ImageRenditionRepository interface {
+	CreateImageRenditions(db database.Database, renditions []*entity.ImageRendition) error
+	FindImageRenditions(db database.Database, bucketType enum.BucketType, originalKey string) ([]*entity.ImageRendition, error)
+	DeleteImageRenditions(db database.Database, bucketType enum.BucketType, originalKey string) error
+}
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type IngredientRepository interface {
+	CreateIngredient(db database.Database, ingredient *entity.Ingredient) error
+	UpdateIngredient(db database.Database, ingredient *entity.Ingredient) error
+	DeleteIngredient(db database.Database, ingredientID uint) error
+	FindIngredientByID(db database.Database, ingredientID uint) (*entity.Ingredient, error)
+	FindIngredientByINCIName(db database.Database, inciName string) (*entity.Ingredient, error)
+	FindIngredientsByIDs(db database.Database, ingredientIDs []uint) ([]entity.Ingredient, error)
+	FindIngredients(db database.Database, search string, opts ...QueryModifier) ([]*entity.Ingredient, error)
+	FindUserAllergies(db database.Database, userID uint) ([]entity.Ingredient, error)
+	ReplaceUserAllergies(db database.Database, user *entity.User, ingredients []entity.Ingredient) error
+	FindUserSkinConcerns(db database.Database, userID uint) ([]enum.SkinConcern, error)
+	ReplaceUserSkinConcerns(db database.Database, userID uint, concerns []enum.SkinConcern) error
+}
@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type NewsCommentRepository interface {
+	CreateComment(db database.Database, comment *entity.NewsComment) error
+	UpdateCommentStatus(db database.Database, commentID uint, status enum.CommentStatus) error
+	IncrementCommentFlagCount(db database.Database, commentID uint) (uint, error)
+	ResetCommentFlagCount(db database.Database, commentID uint) error
+	FindCommentByID(db database.Database, commentID uint) (*entity.NewsComment, error)
+	FindNewsRootComments(db database.Database, newsID uint, statuses []enum.CommentStatus, opts ...QueryModifier) ([]*entity.NewsComment, error)
+	FindCommentReplies(db database.Database, parentIDs []uint, statuses []enum.CommentStatus) ([]*entity.NewsComment, error)
+	FindCommentsByStatus(db database.Database, statuses []enum.CommentStatus, opts ...QueryModifier) ([]*entity.NewsComment, error)
+	CountNewsComments(db database.Database, newsID uint, statuses []enum.CommentStatus) (int64, error)
+	CountUserComments(db database.Database, userID uint, statuses []enum.CommentStatus) (int64, error)
+	FindCommentFlag(db database.Database, commentID, userID uint) (*entity.NewsCommentFlag, error)
+	CreateCommentFlag(db database.Database, flag *entity.NewsCommentFlag) error
+	DeleteCommentFlags(db database.Database, commentID uint) error
+	FindNewsDailyComments(db database.Database, newsID uint, since time.Time) ([]DailyCount, error)
+}
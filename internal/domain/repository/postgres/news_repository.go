@@ -1,19 +1,74 @@
 package postgres
 
 import (
+	"time"
+
 	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
 )
 
+type NewsTagUsage struct {
+	ID        uint
+	Name      string
+	Slug      string
+	NewsCount int64
+}
+
+type DailyCount struct {
+	Day   time.Time
+	Count int64
+}
+
 type NewsRepository interface {
 	FindNewsByID(db database.Database, newsID uint) (*entity.News, error)
-	FindNewsByTittle(db database.Database, title string) (*entity.News, error)
+	FindNewsByTittle(db database.Database, title, slug string) (*entity.News, error)
+	FindNewsBySlug(db database.Database, slug string) (*entity.News, error)
+	IsSlugTaken(db database.Database, slug string, excludeNewsID uint) (bool, error)
+	FindNewsSlugRedirect(db database.Database, slug string) (*entity.NewsSlugRedirect, error)
+	CreateNewsSlugRedirect(db database.Database, redirect *entity.NewsSlugRedirect) error
+	DeleteNewsSlugRedirect(db database.Database, newsID uint, slug string) error
 	FindNewsByStatus(db database.Database, statuses []enum.NewsStatus, opts ...QueryModifier) ([]*entity.News, error)
+	FindNewsDueForPublish(db database.Database, now time.Time) ([]*entity.News, error)
+	FindNewsDueForUnpublish(db database.Database, now time.Time) ([]*entity.News, error)
 	UpdateNews(db database.Database, news *entity.News) error
 	CreateNews(db database.Database, news *entity.News) error
 	DeleteNews(db database.Database, newsID uint) error
 	FindNewsMediaByID(db database.Database, mediaID, newsID uint, ownerType string) (*entity.Media, error)
+	FindNewsMedia(db database.Database, newsID uint, ownerType string) ([]*entity.Media, error)
 	CreateMedia(db database.Database, media *entity.Media) error
 	DeleteMedia(db database.Database, mediaID uint) error
+	CreateNewsCategory(db database.Database, category *entity.NewsCategory) error
+	UpdateNewsCategory(db database.Database, category *entity.NewsCategory) error
+	DeleteNewsCategory(db database.Database, categoryID uint) error
+	FindNewsCategoryByID(db database.Database, categoryID uint) (*entity.NewsCategory, error)
+	FindNewsCategoryByName(db database.Database, name, slug string) (*entity.NewsCategory, error)
+	FindNewsCategories(db database.Database) ([]*entity.NewsCategory, error)
+	FindNewsCategoriesByIDs(db database.Database, categoryIDs []uint) ([]entity.NewsCategory, error)
+	FindNewsCategoriesByNewsID(db database.Database, newsID uint) ([]entity.NewsCategory, error)
+	ReplaceNewsCategories(db database.Database, news *entity.News, categories []entity.NewsCategory) error
+	CreateNewsTag(db database.Database, tag *entity.NewsTag) error
+	FindNewsTagsBySlugs(db database.Database, slugs []string) ([]entity.NewsTag, error)
+	FindNewsTagsByNewsID(db database.Database, newsID uint) ([]entity.NewsTag, error)
+	ReplaceNewsTags(db database.Database, news *entity.News, tags []entity.NewsTag) error
+	FindNewsTagUsages(db database.Database, limit int) ([]NewsTagUsage, error)
+	FindRelatedNews(db database.Database, newsID uint, tagIDs []uint, limit int) ([]*entity.News, error)
+	CreateNewsRevision(db database.Database, revision *entity.NewsRevision) error
+	FindNewsRevisionByID(db database.Database, revisionID, newsID uint) (*entity.NewsRevision, error)
+	FindLatestNewsRevision(db database.Database, newsID uint) (*entity.NewsRevision, error)
+	FindNewsRevisions(db database.Database, newsID uint, opts ...QueryModifier) ([]*entity.NewsRevision, error)
+	IncrementNewsDailyViews(db database.Database, newsID uint, day time.Time, views int64) error
+	SumNewsViews(db database.Database, newsID uint) (int64, error)
+	FindNewsDailyViews(db database.Database, newsID uint, since time.Time) ([]DailyCount, error)
+	CountNewsLikes(db database.Database, newsID uint, ownerType string) (int64, error)
+	FindNewsDailyLikes(db database.Database, newsID uint, ownerType string, since time.Time) ([]DailyCount, error)
+	FindMostViewedNews(db database.Database, since time.Time, limit int) ([]*entity.News, error)
+	CreateNewsPreviewToken(db database.Database, previewToken *entity.NewsPreviewToken) error
+	UpdateNewsPreviewToken(db database.Database, previewToken *entity.NewsPreviewToken) error
+	FindNewsPreviewTokenByID(db database.Database, previewTokenID uint) (*entity.NewsPreviewToken, error)
+	FindActiveNewsPreviewTokens(db database.Database, newsID uint, now time.Time) ([]*entity.NewsPreviewToken, error)
+	FindNewsTranslation(db database.Database, newsID uint, locale string) (*entity.NewsTranslation, error)
+	FindNewsTranslations(db database.Database, newsID uint) ([]*entity.NewsTranslation, error)
+	SaveNewsTranslation(db database.Database, translation *entity.NewsTranslation) error
+	DeleteNewsTranslation(db database.Database, translationID uint) error
 }
@@ -0,0 +1,16 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type PendingUploadRepository interface {
+	CreatePendingUpload(db database.Database, pendingUpload *entity.PendingUpload) error
+	FindPendingUploadByID(db database.Database, uploadID uint) (*entity.PendingUpload, error)
+	FindExpiredPendingUploads(db database.Database, now time.Time, opts ...QueryModifier) ([]*entity.PendingUpload, error)
+	DeletePendingUpload(db database.Database, uploadID uint) (bool, error)
+	DeleteExpiredPendingUpload(db database.Database, uploadID uint, now time.Time) (bool, error)
+}
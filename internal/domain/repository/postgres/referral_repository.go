@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type ReferralRepository interface {
+	CreateReferral(db database.Database, referral *entity.Referral) error
+	UpdateReferral(db database.Database, referral *entity.Referral) error
+	FindReferralByRefereeID(db database.Database, refereeID uint) (*entity.Referral, error)
+	FindReferralByRefereeIDForUpdate(db database.Database, refereeID uint) (*entity.Referral, error)
+	FindReferralsByStatus(db database.Database, statuses []enum.ReferralStatus, opts ...QueryModifier) ([]*entity.Referral, error)
+	FindReferralByIDForUpdate(db database.Database, referralID uint) (*entity.Referral, error)
+	CountReferralsByDeviceID(db database.Database, deviceID string) (int64, error)
+	CountReferralsByIP(db database.Database, registrationIP string, since time.Time) (int64, error)
+	CountReferrerReferrals(db database.Database, referrerID uint, statuses []enum.ReferralStatus) (int64, error)
+	CountReferralsByStatus(db database.Database, status enum.ReferralStatus) (int64, error)
+	SumConvertedRewards(db database.Database) (uint, error)
+	CountRewardedAddresses(db database.Database, address *entity.Address, excludedOwnerID uint) (int64, error)
+}
@@ -9,11 +9,15 @@ import (
 type UserRepository interface {
 	FindUsers(db database.Database) ([]*entity.User, error)
 	FindUserByID(db database.Database, id uint) (*entity.User, error)
+	FindUsersByIDs(db database.Database, ids []uint) ([]*entity.User, error)
 	FindUserByPhone(db database.Database, phone string) (*entity.User, error)
 	FindUserByEmail(db database.Database, email string) (*entity.User, error)
+	FindUserByReferralCode(db database.Database, code string) (*entity.User, error)
 	CreateUser(db database.Database, user *entity.User) error
 	DeleteUserByPhone(db database.Database, phone string) error
 	UpdateUser(db database.Database, user *entity.User) error
+	UpdateUserReferralCode(db database.Database, userID uint, code string) error
+	AddUserRewardPoints(db database.Database, userID uint, points uint) error
 	FindUserRoles(db database.Database, user *entity.User) error
 	FindRoleByName(db database.Database, name string) (*entity.Role, error)
 	FindRolePermissions(db database.Database, role *entity.Role) error
@@ -0,0 +1,12 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+type FeedCacheRepository interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, document []byte, expiration time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
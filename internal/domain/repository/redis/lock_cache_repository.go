@@ -0,0 +1,11 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+type LockCacheRepository interface {
+	AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, error)
+	ReleaseLock(ctx context.Context, key, token string) error
+}
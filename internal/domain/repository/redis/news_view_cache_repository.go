@@ -0,0 +1,12 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+type NewsViewCacheRepository interface {
+	MarkViewed(ctx context.Context, key string, window time.Duration) (bool, error)
+	IncrementPendingViews(ctx context.Context, key, field string, views int64) error
+	PopPendingViews(ctx context.Context, key string) (map[string]int64, error)
+}
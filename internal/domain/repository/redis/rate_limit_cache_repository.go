@@ -0,0 +1,10 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+type RateLimitCacheRepository interface {
+	Increment(ctx context.Context, key string, window time.Duration) (int64, error)
+}
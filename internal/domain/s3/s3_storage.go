@@ -7,8 +7,23 @@ import (
 	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
 )
 
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
 type S3Storage interface {
 	DeleteObject(bucketType enum.BucketType, key string) error
 	GetPresignedURL(bucketType enum.BucketType, objectKey string, expiration time.Duration) (string, error)
 	UploadObject(bucketType enum.BucketType, key string, file *multipart.FileHeader) error
+	PutObject(bucketType enum.BucketType, key string, body []byte, contentType string) error
+	GetObject(bucketType enum.BucketType, key string) ([]byte, error)
+	HeadObject(bucketType enum.BucketType, key string) (*ObjectInfo, error)
+	GetPresignedUploadURL(bucketType enum.BucketType, key, contentType string, size int64, expiration time.Duration) (string, error)
+}
+
+type SignedObjectStore interface {
+	VerifySignature(method, bucket, key, contentType string, expires int64, signature string) bool
+	ReadObject(bucket, key string) ([]byte, string, error)
+	WriteObject(bucket, key string, body []byte) error
 }
@@ -0,0 +1,10 @@
+package sanitizer
+
+// URLRewriter is called for every URL attribute that passed the scheme check.
+// It returns the value to keep, or false to drop the attribute.
+type URLRewriter func(tag, attribute, value string) (string, bool)
+
+type HTMLSanitizer interface {
+	Sanitize(input string, rewrite URLRewriter) string
+	PlainText(input string) string
+}
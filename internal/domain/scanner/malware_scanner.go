@@ -0,0 +1,5 @@
+package scanner
+
+type MalwareScanner interface {
+	Scan(data []byte) (bool, error)
+}
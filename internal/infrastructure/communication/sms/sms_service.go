@@ -29,3 +29,15 @@ func (smsService *SMSService) SendOTP(receptor, token string) error {
 	}
 	return nil
 }
+
+func (smsService *SMSService) SendGiftCard(receptor, code, value string) error {
+	api := kavenegar.New(smsService.providerConfig.APIKey)
+	template := smsService.smsTemplates.GiftCard
+	params := &kavenegar.VerifyLookupParam{
+		Token2: value,
+	}
+	if _, err := api.Verify.Lookup(receptor, template, code, params); err != nil {
+		return err
+	}
+	return nil
+}
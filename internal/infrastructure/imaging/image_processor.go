@@ -0,0 +1,176 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/imaging"
+	"github.com/gen2brain/webp"
+)
+
+const (
+	contentTypeJPEG = "image/jpeg"
+	contentTypePNG  = "image/png"
+	contentTypeWebP = "image/webp"
+)
+
+type ImageProcessor struct {
+	config *bootstrap.ImageProcessing
+}
+
+func NewImageProcessor(config *bootstrap.ImageProcessing) *ImageProcessor {
+	return &ImageProcessor{
+		config: config,
+	}
+}
+
+func (processor *ImageProcessor) IsSupported(data []byte) bool {
+	contentType := http.DetectContentType(data)
+	return contentType == contentTypeJPEG || contentType == contentTypePNG
+}
+
+func (processor *ImageProcessor) decode(data []byte) (*image.RGBA, string, error) {
+	contentType := http.DetectContentType(data)
+	if contentType != contentTypeJPEG && contentType != contentTypePNG {
+		return nil, "", fmt.Errorf("unsupported image type %q", contentType)
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to decode image, %w", err)
+	}
+
+	source := toRGBA(decoded)
+	if contentType == contentTypeJPEG {
+		source = applyOrientation(source, readJPEGOrientation(data))
+	}
+	return source, contentType, nil
+}
+
+// Sanitize decodes the image, applies its EXIF orientation, caps it to
+// maxDimension and re-encodes it in its own format. Re-encoding drops every
+// metadata segment, so the result carries no EXIF or GPS data.
+func (processor *ImageProcessor) Sanitize(data []byte, maxDimension int) (imaging.Image, error) {
+	source, contentType, err := processor.decode(data)
+	if err != nil {
+		return imaging.Image{}, err
+	}
+
+	bounds := source.Bounds()
+	if bounds.Dx() > maxDimension || bounds.Dy() > maxDimension {
+		width, height := fitWithin(bounds.Dx(), bounds.Dy(), maxDimension)
+		source = resize(source, width, height)
+	}
+	return processor.encode(source, contentType)
+}
+
+// Process builds the renditions of a sanitized image, once in its own format and
+// once as WebP, plus a WebP copy of the image itself.
+func (processor *ImageProcessor) Process(data []byte, renditionWidths map[string]int) (imaging.ProcessedImage, error) {
+	source, contentType, err := processor.decode(data)
+	if err != nil {
+		return imaging.ProcessedImage{}, err
+	}
+
+	processed := imaging.ProcessedImage{
+		Renditions: make(map[string]imaging.Image),
+		WebP:       make(map[string]imaging.Image),
+	}
+	if processed.OriginalWebP, err = processor.encode(source, contentTypeWebP); err != nil {
+		return imaging.ProcessedImage{}, err
+	}
+
+	for name, width := range renditionWidths {
+		if width >= source.Bounds().Dx() {
+			continue
+		}
+		height := source.Bounds().Dy() * width / source.Bounds().Dx()
+		resized := resize(source, width, max(height, 1))
+		if processed.Renditions[name], err = processor.encode(resized, contentType); err != nil {
+			return imaging.ProcessedImage{}, err
+		}
+		if processed.WebP[name], err = processor.encode(resized, contentTypeWebP); err != nil {
+			return imaging.ProcessedImage{}, err
+		}
+	}
+
+	return processed, nil
+}
+
+func (processor *ImageProcessor) encode(img *image.RGBA, contentType string) (imaging.Image, error) {
+	var buffer bytes.Buffer
+	var err error
+	switch contentType {
+	case contentTypePNG:
+		err = png.Encode(&buffer, img)
+	case contentTypeWebP:
+		err = webp.Encode(&buffer, img, webp.Options{Quality: processor.config.WebPQuality, Method: webp.DefaultMethod})
+	default:
+		err = jpeg.Encode(&buffer, img, &jpeg.Options{Quality: processor.config.JPEGQuality})
+	}
+	if err != nil {
+		return imaging.Image{}, fmt.Errorf("unable to encode image, %w", err)
+	}
+
+	return imaging.Image{
+		Data:        buffer.Bytes(),
+		ContentType: contentType,
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+	}, nil
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Src)
+	return rgba
+}
+
+func fitWithin(width, height, maxDimension int) (int, int) {
+	if width >= height {
+		return maxDimension, max(height*maxDimension/width, 1)
+	}
+	return max(width*maxDimension/height, 1), maxDimension
+}
+
+// resize downsamples with an area-averaging box filter, which is good enough for
+// thumbnails and avoids pulling in an external imaging dependency.
+func resize(src *image.RGBA, width, height int) *image.RGBA {
+	srcWidth, srcHeight := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := y * srcHeight / height
+		y1 := max((y+1)*srcHeight/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := x * srcWidth / width
+			x1 := max((x+1)*srcWidth/width, x0+1)
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(src.Pix[offset])
+					g += uint64(src.Pix[offset+1])
+					b += uint64(src.Pix[offset+2])
+					a += uint64(src.Pix[offset+3])
+					offset += 4
+					count++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / count)
+			dst.Pix[offset+1] = uint8(g / count)
+			dst.Pix[offset+2] = uint8(b / count)
+			dst.Pix[offset+3] = uint8(a / count)
+		}
+	}
+	return dst
+}
@@ -1,30 +1,50 @@
 package localization
 
 var English = map[string]interface{}{
-	"firstName":           "first name",
-	"lastName":            "last name",
-	"phone":               "phone",
-	"password":            "password",
-	"confirmPassword":     "confirm password",
-	"isAcceptTerms":       "terms and conditions",
-	"nationalID":          "national ID",
-	"registrationNumber":  "registration number",
-	"iban":                "iban",
-	"user":                "user",
-	"address":             "address",
-	"name":                "name",
-	"province":            "province",
-	"city":                "city",
-	"page":                "page",
-	"email":               "email",
-	"role":                "role",
-	"permission":          "permission",
-	"news":                "news",
+	"firstName":          "first name",
+	"lastName":           "last name",
+	"phone":              "phone",
+	"password":           "password",
+	"confirmPassword":    "confirm password",
+	"isAcceptTerms":      "terms and conditions",
+	"nationalID":         "national ID",
+	"registrationNumber": "registration number",
+	"iban":               "iban",
+	"user":               "user",
+	"address":            "address",
+	"name":               "name",
+	"province":           "province",
+	"city":               "city",
+	"page":               "page",
+	"email":              "email",
+	"role":               "role",
+	"permission":         "permission",
+	"news":               "news",
 	"title":              "title",
-	"media":               "media",
-	"post":                "post",
-	"like":                "like",
-	"unlike":              "unlike",
+	"media":              "media",
+	"post":               "post",
+	"like":               "like",
+	"unlike":             "unlike",
+	"referral":           "referral",
+	"referralCode":       "referral code",
+	"giftCard":           "gift card",
+	"amount":             "amount",
+	"ingredient":         "ingredient",
+	"inciName":           "INCI name",
+	"skinType":           "skin type",
+	"skinConcern":        "skin concern",
+	"file":               "file",
+	"upload":             "upload",
+	"publishAt":          "publish time",
+	"unpublishAt":        "unpublish time",
+	"newsCategory":       "news category",
+	"newsTag":            "news tag",
+	"comment":            "comment",
+	"revision":           "revision",
+	"newsPreview":        "news preview",
+	"expiresInHours":     "link lifetime",
+	"locale":             "language",
+	"newsTranslation":    "news translation",
 	"errors": map[string]interface{}{
 		"generic":                "an error occurred, please try again.",
 		"numeric":                "The {0} should be a numeric value.",
@@ -52,6 +72,7 @@ var English = map[string]interface{}{
 		"notExist":               "This {0} does not exist.",
 		"alreadyExist":           "This {0} already exists.",
 		"notFound":               "This {0} not found.",
+		"deliveryFailed":         "The {0} could not be delivered. Please try again later.",
 		"notVerified":            "You have to verify your account first.",
 		"notActive":              "This {0} is not active",
 		"rateLimitExceed":        "Rate limit exceeded. try again later.",
@@ -67,44 +88,81 @@ var English = map[string]interface{}{
 		"alreadyRejected":        "This {0} has been already rejected.",
 		"alreadyAccepted":        "This {0} has been already accepted.",
 		"alreadyDraft":           "This {0} has been already drafted.",
+		"expiredItem":            "This {0} has been expired.",
+		"insufficientBalance":    "The {0} balance is not enough.",
+		"unsupportedFileType":    "This {0} type is not allowed.",
+		"fileTooLarge":           "The {0} is too large.",
+		"invalidDimensions":      "The {0} dimensions are not allowed.",
+		"malwareDetected":        "The {0} was rejected by the security scan.",
+		"futureTime":             "The {0} must be in the future.",
+		"afterPublishTime":       "The {0} must be after the publish time.",
+		"invalidReply":           "Replies are only allowed on approved top-level comments of the same news.",
+		"alreadyFlagged":         "You have already reported this {0}.",
+		"exceedsMaximum":         "The {0} exceeds the allowed maximum.",
 	},
 	"successMessage": map[string]interface{}{
-		"userRegister":               "Registration Successful! Please check your messages to verify your account and complete the registration process.",
-		"phoneVerification":          "Your phone has been successfully verified.",
-		"login":                      "Logged in successfully.",
-		"addAddress":                 "Address added successfully.",
-		"editAddress":                "Address edited successfully.",
-		"deleteAddress":              "Address deleted successfully.",
-		"changePassword":             "Password changed successfully.",
-		"forgotPassword":             "Please check your messages to verify your phone number.",
-		"resetPassword":              "Password reset successfully.",
-		"createAddress":              "Address created successfully.",
-		"refreshToken":               "Your session has been successfully refreshed.",
-		"completeRegister":           "Your registration has been completed successfully.",
-		"emailVerification":          "Your email has been successfully verified.",
-		"updateProfile":              "Profile has been updated successfully",
-		"createRole":                 "New role has been created successfully.",
-		"deleteRole":                 "New role has been deleted successfully.",
-		"updateRole":                 "New role has been updated successfully.",
-		"updateUserRoles":            "User roles has been updated successfully.",
-		"banUser":                    "User get banned successfully.",
-		"unBanUser":                  "User get unbanned successfully.",
-		"createDraftNews":            "Draft news has been created successfully.",
-		"editNews":                   "News has been updated successfully.",
-		"publishNews":                "News has been successfully published.",
-		"unpublishNews":              "News has been successfully drafted.",
-		"deleteNews":                 "ُSelected news has been successfully deleted.",
-		"addMedia":                   "Media has been added successfully.",
-		"deleteMedia":                "Media has been deleted successfully.",
-		"createPost":                 "Post has been created successfully.",
-		"deletePost":                 "Post has been deleted successfully.",
-		"editPost":                   "Post has been updated successfully.",
-		"publishPost":                "Post has been successfully published.",
-		"unpublishPost":              "Post has been successfully drafted.",
-		"likePost":                   "Post has been liked successfully.",
-		"unlikePost":                 "Post has been unliked successfully.",
+		"userRegister":                  "Registration Successful! Please check your messages to verify your account and complete the registration process.",
+		"phoneVerification":             "Your phone has been successfully verified.",
+		"login":                         "Logged in successfully.",
+		"addAddress":                    "Address added successfully.",
+		"editAddress":                   "Address edited successfully.",
+		"deleteAddress":                 "Address deleted successfully.",
+		"changePassword":                "Password changed successfully.",
+		"forgotPassword":                "Please check your messages to verify your phone number.",
+		"resetPassword":                 "Password reset successfully.",
+		"createAddress":                 "Address created successfully.",
+		"refreshToken":                  "Your session has been successfully refreshed.",
+		"completeRegister":              "Your registration has been completed successfully.",
+		"emailVerification":             "Your email has been successfully verified.",
+		"updateProfile":                 "Profile has been updated successfully",
+		"createRole":                    "New role has been created successfully.",
+		"deleteRole":                    "New role has been deleted successfully.",
+		"updateRole":                    "New role has been updated successfully.",
+		"updateUserRoles":               "User roles has been updated successfully.",
+		"banUser":                       "User get banned successfully.",
+		"unBanUser":                     "User get unbanned successfully.",
+		"createDraftNews":               "Draft news has been created successfully.",
+		"editNews":                      "News has been updated successfully.",
+		"publishNews":                   "News has been successfully published.",
+		"unpublishNews":                 "News has been successfully drafted.",
+		"scheduleNews":                  "News has been successfully scheduled.",
+		"deleteNews":                    "ُSelected news has been successfully deleted.",
+		"addMedia":                      "Media has been added successfully.",
+		"deleteMedia":                   "Media has been deleted successfully.",
+		"createUpload":                  "Upload URL has been created successfully.",
+		"confirmUpload":                 "Upload has been confirmed successfully.",
+		"createNewsCategory":            "News category has been created successfully.",
+		"updateNewsCategory":            "News category has been updated successfully.",
+		"deleteNewsCategory":            "News category has been deleted successfully.",
+		"updateNewsTaxonomy":            "News categories and tags have been updated successfully.",
+		"createComment":                 "Your comment has been submitted successfully.",
+		"createPendingComment":          "Your comment has been submitted and will be shown after review.",
+		"flagComment":                   "Comment has been reported successfully.",
+		"reviewComment":                 "Comment has been reviewed successfully.",
+		"reviewReferral":                "Referral has been reviewed successfully.",
+		"restoreRevision":               "News has been restored to the selected revision successfully.",
+		"createNewsPreview":             "Preview link has been created successfully.",
+		"revokeNewsPreview":             "Preview link has been revoked successfully.",
+		"saveNewsTranslation":           "News translation has been saved successfully.",
+		"deleteNewsTranslation":         "News translation has been deleted successfully.",
+		"createPost":                    "Post has been created successfully.",
+		"deletePost":                    "Post has been deleted successfully.",
+		"editPost":                      "Post has been updated successfully.",
+		"publishPost":                   "Post has been successfully published.",
+		"unpublishPost":                 "Post has been successfully drafted.",
+		"likePost":                      "Post has been liked successfully.",
+		"unlikePost":                    "Post has been unliked successfully.",
+		"issueGiftCard":                 "Gift card has been issued successfully.",
+		"disableGiftCard":               "Gift card has been disabled successfully.",
+		"resendGiftCard":                "Gift card has been sent to the recipient successfully.",
+		"updateNotificationPreferences": "Notification preferences have been updated successfully.",
+		"createIngredient":              "Ingredient has been created successfully.",
+		"updateIngredient":              "Ingredient has been updated successfully.",
+		"deleteIngredient":              "Ingredient has been deleted successfully.",
+		"updateSkinProfile":             "Skin profile has been updated successfully.",
 	},
 	"emailSubject": map[string]interface{}{
 		"emailConfirmation": "Verify Your Email Address",
+		"giftCard":          "Your Gift Card",
 	},
 }
@@ -1,30 +1,50 @@
 package localization
 
 var Persian = map[string]interface{}{
-	"firstName":           "نام",
-	"lastName":            "نام خانوادگی",
-	"phone":               "شماره تماس",
-	"password":            "رمز عبور",
-	"confirmPassword":     "تایید رمز عیور",
-	"isAcceptTerms":       "تایید شرایط و مقررات",
-	"nationalID":          "شناسه ملی",
-	"registrationNumber":  "شماره ثبت",
-	"iban":                "شماره شبا",
-	"user":                "کاربر",
-	"address":             "آدرس",
-	"name":                "نام",
-	"province":            "استان",
-	"city":                "شهر",
-	"page":                "صفحه",
-	"email":               "ایمیل",
-	"role":                "نقش",
-	"permission":          "دسترسی",
-	"news":                "اخبار",
+	"firstName":          "نام",
+	"lastName":           "نام خانوادگی",
+	"phone":              "شماره تماس",
+	"password":           "رمز عبور",
+	"confirmPassword":    "تایید رمز عیور",
+	"isAcceptTerms":      "تایید شرایط و مقررات",
+	"nationalID":         "شناسه ملی",
+	"registrationNumber": "شماره ثبت",
+	"iban":               "شماره شبا",
+	"user":               "کاربر",
+	"address":            "آدرس",
+	"name":               "نام",
+	"province":           "استان",
+	"city":               "شهر",
+	"page":               "صفحه",
+	"email":              "ایمیل",
+	"role":               "نقش",
+	"permission":         "دسترسی",
+	"news":               "اخبار",
 	"title":              "عنوان",
-	"media":               "محتوا",
-	"post":                "پست",
-	"like":                "لایک",
-	"unlike":              "حذف لایک",
+	"media":              "محتوا",
+	"post":               "پست",
+	"like":               "لایک",
+	"unlike":             "حذف لایک",
+	"referral":           "معرفی",
+	"referralCode":       "کد معرف",
+	"giftCard":           "کارت هدیه",
+	"amount":             "مبلغ",
+	"ingredient":         "ترکیب",
+	"inciName":           "نام INCI",
+	"skinType":           "نوع پوست",
+	"skinConcern":        "دغدغه پوستی",
+	"file":               "فایل",
+	"upload":             "آپلود",
+	"publishAt":          "زمان انتشار",
+	"unpublishAt":        "زمان پایان انتشار",
+	"newsCategory":       "دسته بندی خبر",
+	"newsTag":            "برچسب خبر",
+	"comment":            "نظر",
+	"revision":           "نسخه",
+	"newsPreview":        "پیش نمایش خبر",
+	"expiresInHours":     "مدت اعتبار لینک",
+	"locale":             "زبان",
+	"newsTranslation":    "ترجمه خبر",
 	"errors": map[string]interface{}{
 		"generic":                "خطایی رخ داده است، لطفا دوباره تلاش کنید.",
 		"numeric":                "`{0}` باید عدد باشد.",
@@ -51,6 +71,7 @@ var Persian = map[string]interface{}{
 		"notExist":               "این {0} وجود ندارد.",
 		"alreadyExist":           "این {0} قبلا وجود داشته است.",
 		"notFound":               "این {0} پیدا نشد.",
+		"deliveryFailed":         "ارسال این {0} ناموفق بود. لطفا بعدا دوباره تلاش کنید.",
 		"notVerified":            "باید ابتدا حساب خود را فعال کنید.",
 		"notActive":              "این {0} فعال نیست.",
 		"rateLimitExceed":        "بیشتر از حد مجاز درخواست ثبت کرده اید.",
@@ -68,45 +89,82 @@ var Persian = map[string]interface{}{
 		"alreadyRejected":        "این {0} قبلا رد شده است.",
 		"alreadyAccepted":        "این {0} قبلا قبول شده است.",
 		"alreadyDraft":           "این {0} قبلا در حالت پیش نویس قرار گرفته است.",
+		"expiredItem":            "این {0} منقضی شده است.",
+		"insufficientBalance":    "موجودی {0} کافی نیست.",
+		"unsupportedFileType":    "نوع این {0} مجاز نیست.",
+		"fileTooLarge":           "حجم {0} بیش از حد مجاز است.",
+		"invalidDimensions":      "ابعاد {0} مجاز نیست.",
+		"malwareDetected":        "{0} توسط بررسی امنیتی رد شد.",
+		"futureTime":             "{0} باید در آینده باشد.",
+		"afterPublishTime":       "{0} باید بعد از زمان انتشار باشد.",
+		"invalidReply":           "پاسخ فقط به نظرهای تایید شده سطح اول همین خبر امکان پذیر است.",
+		"alreadyFlagged":         "شما قبلا این {0} را گزارش کرده اید.",
+		"exceedsMaximum":         "{0} بیشتر از حد مجاز است.",
 	},
 	"successMessage": map[string]interface{}{
-		"userRegister":              "ثبت نام موفق بود! لطفاً پیامک های خود را بررسی کنید تا حساب خود را تأیید کرده و فرآیند ثبت نام را تکمیل نمایید.",
-		"phoneVerification":         "شماره شما با موفقیت تایید شد.",
-		"login":                     "شما با موفقیت وارد شدید.",
-		"changeLogo":                "لوگوی شرکت ب موفقیت عوض شد.",
-		"addAddress":                "آدرس با موفقیت اضافه شد.",
-		"editAddress":               "آدرس با موفقیت ویرایش شد.",
-		"deleteAddress":             "آدرس با موفقیت حذف شد.",
-		"changePassword":            "رمز عبور با موفقیت تغییر کرد.",
-		"forgotPassword":            "لطفا پیامک های خود را بررسی کنید تا تلفن خود را تایید نمایید .",
-		"resetPassword":             "گذرواژه با موفقیت تغییر کرد.",
-		"createAddress":             "آدرس مورد نظر با موفقیت اضافه شد.",
-		"refreshToken":              "نشست شما با موفقیت تمدید شد.",
-		"completeRegister":          "اطلاعات تکمیلی ثبت نام با موفقیت انجام شد.",
-		"emailVerification":         "ایمیل شما با موفقیت تایید شد.",
-		"updateProfile":             "پروفایل شما با موفقیت به روزرسانی شد.",
-		"createRole":                "نقش جدید با موفقیت ساخته شد.",
-		"deleteRole":                "نقش مورد نظر با موفقیت حذف شد..",
-		"updateRole":                "نقش مورد نظر با موفقیت به روزرسانی شد.",
-		"updateUserRoles":           "نقش های فرد مورد نظر به روزرسانی شد.",
-		"banUser":                   "کاربر با موفقیت محدود شد.",
-		"unBanUser":                 "کاربر با موفقیت رفع محدود شد.",
-		"createDraftNews":           "پیش نویس خبر با موفقیت ساخته شد.",
-		"editNews":                  "خبر با موفقیت به روز رسانی شد.",
-		"publishNews":               "خبر با موفقیت منتشر شد.",
-		"unpublishNews":             "خبر با موفقیت به حالت پیش نویس تغییر کرد.",
-		"deleteNews":                "اخبار مورد نظر شما با موفقیت حذف شدند.",
-		"addMedia":                  "محتوای مورد نظر با موفقیت آپلود شد.",
-		"deleteMedia":               "محتوای مورد نظر با موفقیت حذف شد.",
-		"createPost":                "پست با موفقیت ساخته شد.",
-		"deletePost":                "پست با موفقیت حذف شد.",
-		"editPost":                  "پست با موفقیت به روز رسانی شد.",
-		"publishPost":               "پست با موفقیت منتشر شد.",
-		"unpublishPost":             "پست با موفقیت به حالت پیش نویس تغییر کرد.",
-		"likePost":                  "پست با موفقیت لایک شد.",
-		"unlikePost":                "لایک پست با موفقیت حذف شد.",
+		"userRegister":                  "ثبت نام موفق بود! لطفاً پیامک های خود را بررسی کنید تا حساب خود را تأیید کرده و فرآیند ثبت نام را تکمیل نمایید.",
+		"phoneVerification":             "شماره شما با موفقیت تایید شد.",
+		"login":                         "شما با موفقیت وارد شدید.",
+		"changeLogo":                    "لوگوی شرکت ب موفقیت عوض شد.",
+		"addAddress":                    "آدرس با موفقیت اضافه شد.",
+		"editAddress":                   "آدرس با موفقیت ویرایش شد.",
+		"deleteAddress":                 "آدرس با موفقیت حذف شد.",
+		"changePassword":                "رمز عبور با موفقیت تغییر کرد.",
+		"forgotPassword":                "لطفا پیامک های خود را بررسی کنید تا تلفن خود را تایید نمایید .",
+		"resetPassword":                 "گذرواژه با موفقیت تغییر کرد.",
+		"createAddress":                 "آدرس مورد نظر با موفقیت اضافه شد.",
+		"refreshToken":                  "نشست شما با موفقیت تمدید شد.",
+		"completeRegister":              "اطلاعات تکمیلی ثبت نام با موفقیت انجام شد.",
+		"emailVerification":             "ایمیل شما با موفقیت تایید شد.",
+		"updateProfile":                 "پروفایل شما با موفقیت به روزرسانی شد.",
+		"createRole":                    "نقش جدید با موفقیت ساخته شد.",
+		"deleteRole":                    "نقش مورد نظر با موفقیت حذف شد..",
+		"updateRole":                    "نقش مورد نظر با موفقیت به روزرسانی شد.",
+		"updateUserRoles":               "نقش های فرد مورد نظر به روزرسانی شد.",
+		"banUser":                       "کاربر با موفقیت محدود شد.",
+		"unBanUser":                     "کاربر با موفقیت رفع محدود شد.",
+		"createDraftNews":               "پیش نویس خبر با موفقیت ساخته شد.",
+		"editNews":                      "خبر با موفقیت به روز رسانی شد.",
+		"publishNews":                   "خبر با موفقیت منتشر شد.",
+		"unpublishNews":                 "خبر با موفقیت به حالت پیش نویس تغییر کرد.",
+		"scheduleNews":                  "خبر با موفقیت زمان بندی شد.",
+		"deleteNews":                    "اخبار مورد نظر شما با موفقیت حذف شدند.",
+		"addMedia":                      "محتوای مورد نظر با موفقیت آپلود شد.",
+		"deleteMedia":                   "محتوای مورد نظر با موفقیت حذف شد.",
+		"createUpload":                  "لینک آپلود با موفقیت ایجاد شد.",
+		"confirmUpload":                 "آپلود با موفقیت تایید شد.",
+		"createNewsCategory":            "دسته بندی خبر با موفقیت ساخته شد.",
+		"updateNewsCategory":            "دسته بندی خبر با موفقیت به روزرسانی شد.",
+		"deleteNewsCategory":            "دسته بندی خبر با موفقیت حذف شد.",
+		"updateNewsTaxonomy":            "دسته بندی ها و برچسب های خبر با موفقیت به روزرسانی شد.",
+		"createComment":                 "نظر شما با موفقیت ثبت شد.",
+		"createPendingComment":          "نظر شما ثبت شد و پس از بررسی نمایش داده می شود.",
+		"flagComment":                   "نظر با موفقیت گزارش شد.",
+		"reviewComment":                 "نظر با موفقیت بررسی شد.",
+		"reviewReferral":                "معرفی با موفقیت بررسی شد.",
+		"restoreRevision":               "خبر با موفقیت به نسخه انتخاب شده بازگردانده شد.",
+		"createNewsPreview":             "لینک پیش نمایش با موفقیت ساخته شد.",
+		"revokeNewsPreview":             "لینک پیش نمایش با موفقیت باطل شد.",
+		"saveNewsTranslation":           "ترجمه خبر با موفقیت ذخیره شد.",
+		"deleteNewsTranslation":         "ترجمه خبر با موفقیت حذف شد.",
+		"createPost":                    "پست با موفقیت ساخته شد.",
+		"deletePost":                    "پست با موفقیت حذف شد.",
+		"editPost":                      "پست با موفقیت به روز رسانی شد.",
+		"publishPost":                   "پست با موفقیت منتشر شد.",
+		"unpublishPost":                 "پست با موفقیت به حالت پیش نویس تغییر کرد.",
+		"likePost":                      "پست با موفقیت لایک شد.",
+		"unlikePost":                    "لایک پست با موفقیت حذف شد.",
+		"issueGiftCard":                 "کارت هدیه با موفقیت صادر شد.",
+		"disableGiftCard":               "کارت هدیه با موفقیت غیرفعال شد.",
+		"resendGiftCard":                "کارت هدیه با موفقیت برای گیرنده ارسال شد.",
+		"updateNotificationPreferences": "تنظیمات اطلاع رسانی با موفقیت به روزرسانی شد.",
+		"createIngredient":              "ترکیب با موفقیت ساخته شد.",
+		"updateIngredient":              "ترکیب با موفقیت به روزرسانی شد.",
+		"deleteIngredient":              "ترکیب با موفقیت حذف شد.",
+		"updateSkinProfile":             "پروفایل پوستی شما با موفقیت به روزرسانی شد.",
 	},
 	"emailSubject": map[string]interface{}{
 		"emailConfirmation": "تأیید آدرس ایمیل شما",
+		"giftCard":          "کارت هدیه شما",
 	},
 }
@@ -10,6 +10,7 @@ type PrometheusMetrics struct {
 	metrics             *bootstrap.Metrics
 	httpRequestsTotal   *prometheus.CounterVec
 	httpRequestDuration *prometheus.HistogramVec
+	businessEventsTotal *prometheus.CounterVec
 }
 
 func NewPrometheusMetrics(metrics *bootstrap.Metrics) *PrometheusMetrics {
@@ -25,12 +26,19 @@ func NewPrometheusMetrics(metrics *bootstrap.Metrics) *PrometheusMetrics {
 		),
 		httpRequestDuration: promauto.NewHistogramVec(
 			prometheus.HistogramOpts{
-				Name: metrics.HTTPRequestDuration.Name,
-				Help: metrics.HTTPRequestDuration.Help,
+				Name:    metrics.HTTPRequestDuration.Name,
+				Help:    metrics.HTTPRequestDuration.Help,
 				Buckets: prometheus.DefBuckets,
 			},
 			[]string{"method", "route"},
 		),
+		businessEventsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: metrics.BusinessEventsTotal.Name,
+				Help: metrics.BusinessEventsTotal.Help,
+			},
+			[]string{"domain", "event"},
+		),
 	}
 }
 
@@ -41,3 +49,7 @@ func (pm *PrometheusMetrics) IncHTTPRequest(method, route, status string) {
 func (pm *PrometheusMetrics) ObserveHTTPRequestDuration(method, route string, duration float64) {
 	pm.httpRequestDuration.WithLabelValues(method, route).Observe(duration)
 }
+
+func (pm *PrometheusMetrics) IncBusinessEvent(domain, event string) {
+	pm.businessEventsTotal.WithLabelValues(domain, event).Inc()
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	repository "github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GiftCardRepository struct{}
+
+func NewGiftCardRepository() *GiftCardRepository {
+	return &GiftCardRepository{}
+}
+
+func (repo *GiftCardRepository) CreateGiftCard(db database.Database, giftCard *entity.GiftCard) error {
+	return db.GetDB().Create(&giftCard).Error
+}
+
+func (repo *GiftCardRepository) UpdateGiftCard(db database.Database, giftCard *entity.GiftCard) error {
+	return db.GetDB().Save(&giftCard).Error
+}
+
+func (repo *GiftCardRepository) UpdateGiftCardDelivery(db database.Database, giftCardID uint, deliveredAt *time.Time, deliveryError string) error {
+	updates := map[string]interface{}{
+		"delivered_at":   deliveredAt,
+		"delivery_error": deliveryError,
+	}
+	return db.GetDB().Model(&entity.GiftCard{}).Where("id = ?", giftCardID).Updates(updates).Error
+}
+
+func (repo *GiftCardRepository) FindGiftCardByID(db database.Database, giftCardID uint) (*entity.GiftCard, error) {
+	var giftCard entity.GiftCard
+	result := db.GetDB().First(&giftCard, giftCardID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &giftCard, nil
+}
+
+func (repo *GiftCardRepository) FindGiftCardByCode(db database.Database, code string) (*entity.GiftCard, error) {
+	var giftCard entity.GiftCard
+	result := db.GetDB().Where("code = ?", code).First(&giftCard)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &giftCard, nil
+}
+
+func (repo *GiftCardRepository) FindGiftCardByCodeForUpdate(db database.Database, code string) (*entity.GiftCard, error) {
+	var giftCard entity.GiftCard
+	result := db.GetDB().Clauses(clause.Locking{Strength: "UPDATE"}).Where("code = ?", code).First(&giftCard)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &giftCard, nil
+}
+
+func (repo *GiftCardRepository) FindGiftCardsByStatus(db database.Database, statuses []enum.GiftCardStatus, opts ...repository.QueryModifier) ([]*entity.GiftCard, error) {
+	var giftCards []*entity.GiftCard
+	query := db.GetDB().Where("status IN ?", statuses)
+	for _, opt := range opts {
+		query = opt.Apply(query).(*gorm.DB)
+	}
+	result := query.Find(&giftCards)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return giftCards, nil
+}
+
+func (repo *GiftCardRepository) CreateGiftCardTransaction(db database.Database, transaction *entity.GiftCardTransaction) error {
+	return db.GetDB().Create(&transaction).Error
+}
+
+func (repo *GiftCardRepository) FindGiftCardTransactions(db database.Database, giftCardID uint, opts ...repository.QueryModifier) ([]*entity.GiftCardTransaction, error) {
+	var transactions []*entity.GiftCardTransaction
+	query := db.GetDB().Where("gift_card_id = ?", giftCardID)
+	for _, opt := range opts {
+		query = opt.Apply(query).(*gorm.DB)
+	}
+	result := query.Find(&transactions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return transactions, nil
+}
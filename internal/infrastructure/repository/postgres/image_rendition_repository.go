@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+)
+
+type ImageRenditionRepository struct{}
+
+func NewImageRenditionRepository() *ImageRenditionRepository {
+	return &ImageRenditionRepository{}
+}
+
+func (repo *ImageRenditionRepository) CreateImageRenditions(db database.Database, renditions []*entity.ImageRendition) error {
+	return db.GetDB().Create(&renditions).Error
+}
+
+func (repo *ImageRenditionRepository) FindImageRenditions(db database.Database, bucketType enum.BucketType, originalKey string) ([]*entity.ImageRendition, error) {
+	var renditions []*entity.ImageRendition
+	result := db.GetDB().Where("bucket_type = ? AND original_key = ?", bucketType, originalKey).Find(&renditions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return renditions, nil
+}
+
+func (repo *ImageRenditionRepository) DeleteImageRenditions(db database.Database, bucketType enum.BucketType, originalKey string) error {
+	return db.GetDB().Unscoped().Where("bucket_type = ? AND original_key = ?", bucketType, originalKey).Delete(&entity.ImageRendition{}).Error
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"strings"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	repository "github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type IngredientRepository struct{}
+
+func NewIngredientRepository() *IngredientRepository {
+	return &IngredientRepository{}
+}
+
+func (repo *IngredientRepository) CreateIngredient(db database.Database, ingredient *entity.Ingredient) error {
+	return db.GetDB().Create(&ingredient).Error
+}
+
+func (repo *IngredientRepository) UpdateIngredient(db database.Database, ingredient *entity.Ingredient) error {
+	return db.GetDB().Save(&ingredient).Error
+}
+
+func (repo *IngredientRepository) DeleteIngredient(db database.Database, ingredientID uint) error {
+	return db.GetDB().Unscoped().Delete(&entity.Ingredient{}, ingredientID).Error
+}
+
+func (repo *IngredientRepository) FindIngredientByID(db database.Database, ingredientID uint) (*entity.Ingredient, error) {
+	var ingredient entity.Ingredient
+	result := db.GetDB().First(&ingredient, ingredientID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &ingredient, nil
+}
+
+func (repo *IngredientRepository) FindIngredientByINCIName(db database.Database, inciName string) (*entity.Ingredient, error) {
+	var ingredient entity.Ingredient
+	result := db.GetDB().Where("LOWER(inci_name) = ?", strings.ToLower(inciName)).First(&ingredient)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &ingredient, nil
+}
+
+func (repo *IngredientRepository) FindIngredientsByIDs(db database.Database, ingredientIDs []uint) ([]entity.Ingredient, error) {
+	var ingredients []entity.Ingredient
+	result := db.GetDB().Where("id IN ?", ingredientIDs).Find(&ingredients)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return ingredients, nil
+}
+
+func (repo *IngredientRepository) FindIngredients(db database.Database, search string, opts ...repository.QueryModifier) ([]*entity.Ingredient, error) {
+	var ingredients []*entity.Ingredient
+	query := db.GetDB()
+	if search != "" {
+		pattern := "%" + strings.ToLower(search) + "%"
+		query = query.Where("LOWER(inci_name) LIKE ? OR persian_name LIKE ?", pattern, "%"+search+"%")
+	}
+	for _, opt := range opts {
+		query = opt.Apply(query).(*gorm.DB)
+	}
+	result := query.Find(&ingredients)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return ingredients, nil
+}
+
+func (repo *IngredientRepository) FindUserAllergies(db database.Database, userID uint) ([]entity.Ingredient, error) {
+	var ingredients []entity.Ingredient
+	result := db.GetDB().
+		Joins("JOIN user_allergies ON ingredients.id = user_allergies.ingredient_id").
+		Where("user_allergies.user_id = ?", userID).
+		Find(&ingredients)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return ingredients, nil
+}
+
+func (repo *IngredientRepository) ReplaceUserAllergies(db database.Database, user *entity.User, ingredients []entity.Ingredient) error {
+	return db.GetDB().Model(&user).Association("Allergies").Replace(ingredients)
+}
+
+func (repo *IngredientRepository) FindUserSkinConcerns(db database.Database, userID uint) ([]enum.SkinConcern, error) {
+	var concerns []enum.SkinConcern
+	result := db.GetDB().Model(&entity.UserSkinConcern{}).Where("user_id = ?", userID).Pluck("concern", &concerns)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return concerns, nil
+}
+
+func (repo *IngredientRepository) ReplaceUserSkinConcerns(db database.Database, userID uint, concerns []enum.SkinConcern) error {
+	if err := db.GetDB().Where("user_id = ?", userID).Delete(&entity.UserSkinConcern{}).Error; err != nil {
+		return err
+	}
+	if len(concerns) == 0 {
+		return nil
+	}
+
+	skinConcerns := make([]entity.UserSkinConcern, len(concerns))
+	for i, concern := range concerns {
+		skinConcerns[i] = entity.UserSkinConcern{
+			UserID:  userID,
+			Concern: concern,
+		}
+	}
+	return db.GetDB().Create(&skinConcerns).Error
+}
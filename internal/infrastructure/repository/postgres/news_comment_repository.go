@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	repository "github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NewsCommentRepository struct {
+}
+
+func NewNewsCommentRepository() *NewsCommentRepository {
+	return &NewsCommentRepository{}
+}
+
+func (repo *NewsCommentRepository) CreateComment(db database.Database, comment *entity.NewsComment) error {
+	return db.GetDB().Create(&comment).Error
+}
+
+func (repo *NewsCommentRepository) UpdateCommentStatus(db database.Database, commentID uint, status enum.CommentStatus) error {
+	return db.GetDB().Model(&entity.NewsComment{}).Where("id = ?", commentID).UpdateColumn("status", status).Error
+}
+
+// IncrementCommentFlagCount bumps the counter in the database and returns the new
+// value, so concurrent reports are never lost.
+func (repo *NewsCommentRepository) IncrementCommentFlagCount(db database.Database, commentID uint) (uint, error) {
+	var comment entity.NewsComment
+	result := db.GetDB().Model(&comment).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "flag_count"}}}).
+		Where("id = ?", commentID).
+		UpdateColumn("flag_count", gorm.Expr("flag_count + ?", 1))
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return comment.FlagCount, nil
+}
+
+func (repo *NewsCommentRepository) ResetCommentFlagCount(db database.Database, commentID uint) error {
+	return db.GetDB().Model(&entity.NewsComment{}).Where("id = ?", commentID).UpdateColumn("flag_count", 0).Error
+}
+
+func (repo *NewsCommentRepository) FindCommentByID(db database.Database, commentID uint) (*entity.NewsComment, error) {
+	var comment entity.NewsComment
+	result := db.GetDB().First(&comment, commentID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &comment, nil
+}
+
+func (repo *NewsCommentRepository) FindNewsRootComments(db database.Database, newsID uint, statuses []enum.CommentStatus, opts ...repository.QueryModifier) ([]*entity.NewsComment, error) {
+	var comments []*entity.NewsComment
+	query := db.GetDB().Where("news_id = ? AND parent_id IS NULL AND status IN ?", newsID, statuses)
+	for _, opt := range opts {
+		query = opt.Apply(query).(*gorm.DB)
+	}
+	result := query.Find(&comments)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return comments, nil
+}
+
+func (repo *NewsCommentRepository) FindCommentReplies(db database.Database, parentIDs []uint, statuses []enum.CommentStatus) ([]*entity.NewsComment, error) {
+	var comments []*entity.NewsComment
+	result := db.GetDB().Where("parent_id IN ? AND status IN ?", parentIDs, statuses).Order("created_at").Find(&comments)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return comments, nil
+}
+
+func (repo *NewsCommentRepository) FindCommentsByStatus(db database.Database, statuses []enum.CommentStatus, opts ...repository.QueryModifier) ([]*entity.NewsComment, error) {
+	var comments []*entity.NewsComment
+	query := db.GetDB().Where("status IN ?", statuses)
+	for _, opt := range opts {
+		query = opt.Apply(query).(*gorm.DB)
+	}
+	result := query.Find(&comments)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return comments, nil
+}
+
+func (repo *NewsCommentRepository) CountNewsComments(db database.Database, newsID uint, statuses []enum.CommentStatus) (int64, error) {
+	var count int64
+	result := db.GetDB().Model(&entity.NewsComment{}).Where("news_id = ? AND status IN ?", newsID, statuses).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (repo *NewsCommentRepository) CountUserComments(db database.Database, userID uint, statuses []enum.CommentStatus) (int64, error) {
+	var count int64
+	result := db.GetDB().Model(&entity.NewsComment{}).Where("user_id = ? AND status IN ?", userID, statuses).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (repo *NewsCommentRepository) FindCommentFlag(db database.Database, commentID, userID uint) (*entity.NewsCommentFlag, error) {
+	var flag entity.NewsCommentFlag
+	result := db.GetDB().Where("comment_id = ? AND user_id = ?", commentID, userID).First(&flag)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &flag, nil
+}
+
+func (repo *NewsCommentRepository) CreateCommentFlag(db database.Database, flag *entity.NewsCommentFlag) error {
+	return db.GetDB().Create(&flag).Error
+}
+
+func (repo *NewsCommentRepository) DeleteCommentFlags(db database.Database, commentID uint) error {
+	return db.GetDB().Unscoped().Where("comment_id = ?", commentID).Delete(&entity.NewsCommentFlag{}).Error
+}
+
+func (repo *NewsCommentRepository) FindNewsDailyComments(db database.Database, newsID uint, since time.Time) ([]repository.DailyCount, error) {
+	var counts []repository.DailyCount
+	result := db.GetDB().Model(&entity.NewsComment{}).
+		Select("DATE(created_at) AS day, COUNT(*) AS count").
+		Where("news_id = ? AND created_at >= ?", newsID, since).
+		Group("DATE(created_at)").
+		Order("day").
+		Scan(&counts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return counts, nil
+}
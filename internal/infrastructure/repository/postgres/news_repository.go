@@ -1,13 +1,54 @@
 package postgres
 
 import (
+	"time"
+
 	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
 	repository "github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// NewsTaxonomyModifier narrows a news query to the given category and/or tag
+// slugs. Empty slugs are ignored.
+type NewsTaxonomyModifier struct {
+	CategorySlug string
+	TagSlug      string
+}
+
+func NewNewsTaxonomyModifier(categorySlug, tagSlug string) NewsTaxonomyModifier {
+	return NewsTaxonomyModifier{
+		CategorySlug: categorySlug,
+		TagSlug:      tagSlug,
+	}
+}
+
+func (taxonomy NewsTaxonomyModifier) Apply(query interface{}) interface{} {
+	db, ok := query.(*gorm.DB)
+	if !ok {
+		return query
+	}
+	if taxonomy.CategorySlug != "" {
+		categoryNews := db.Session(&gorm.Session{NewDB: true}).
+			Table("news_category_assignments").
+			Select("news_category_assignments.news_id").
+			Joins("JOIN news_categories ON news_categories.id = news_category_assignments.news_category_id").
+			Where("news_categories.slug = ? AND news_categories.deleted_at IS NULL", taxonomy.CategorySlug)
+		db = db.Where("news.id IN (?)", categoryNews)
+	}
+	if taxonomy.TagSlug != "" {
+		tagNews := db.Session(&gorm.Session{NewDB: true}).
+			Table("news_tag_assignments").
+			Select("news_tag_assignments.news_id").
+			Joins("JOIN news_tags ON news_tags.id = news_tag_assignments.news_tag_id").
+			Where("news_tags.slug = ? AND news_tags.deleted_at IS NULL", taxonomy.TagSlug)
+		db = db.Where("news.id IN (?)", tagNews)
+	}
+	return db
+}
+
 type NewsRepository struct {
 }
 
@@ -27,9 +68,9 @@ func (repo *NewsRepository) FindNewsByID(db database.Database, newsID uint) (*en
 	return &news, nil
 }
 
-func (repo *NewsRepository) FindNewsByTittle(db database.Database, title string) (*entity.News, error) {
+func (repo *NewsRepository) FindNewsByTittle(db database.Database, title, slug string) (*entity.News, error) {
 	var news entity.News
-	result := db.GetDB().Where("title = ?", title).First(&news)
+	result := db.GetDB().Where("title = ? OR slug = ?", title, slug).First(&news)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -39,6 +80,56 @@ func (repo *NewsRepository) FindNewsByTittle(db database.Database, title string)
 	return &news, nil
 }
 
+func (repo *NewsRepository) FindNewsBySlug(db database.Database, slug string) (*entity.News, error) {
+	var news entity.News
+	result := db.GetDB().Where("slug = ?", slug).First(&news)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &news, nil
+}
+
+func (repo *NewsRepository) IsSlugTaken(db database.Database, slug string, excludeNewsID uint) (bool, error) {
+	var newsCount int64
+	result := db.GetDB().Unscoped().Model(&entity.News{}).Where("slug = ? AND id <> ?", slug, excludeNewsID).Count(&newsCount)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if newsCount > 0 {
+		return true, nil
+	}
+
+	var redirectCount int64
+	result = db.GetDB().Model(&entity.NewsSlugRedirect{}).Where("slug = ? AND news_id <> ?", slug, excludeNewsID).Count(&redirectCount)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return redirectCount > 0, nil
+}
+
+func (repo *NewsRepository) FindNewsSlugRedirect(db database.Database, slug string) (*entity.NewsSlugRedirect, error) {
+	var redirect entity.NewsSlugRedirect
+	result := db.GetDB().Where("slug = ?", slug).First(&redirect)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &redirect, nil
+}
+
+func (repo *NewsRepository) CreateNewsSlugRedirect(db database.Database, redirect *entity.NewsSlugRedirect) error {
+	return db.GetDB().Create(&redirect).Error
+}
+
+func (repo *NewsRepository) DeleteNewsSlugRedirect(db database.Database, newsID uint, slug string) error {
+	return db.GetDB().Unscoped().Where("news_id = ? AND slug = ?", newsID, slug).Delete(&entity.NewsSlugRedirect{}).Error
+}
+
 func (repo *NewsRepository) FindNewsByStatus(db database.Database, statuses []enum.NewsStatus, opts ...repository.QueryModifier) ([]*entity.News, error) {
 	var news []*entity.News
 	query := db.GetDB().Where("status IN ?", statuses)
@@ -52,6 +143,24 @@ func (repo *NewsRepository) FindNewsByStatus(db database.Database, statuses []en
 	return news, nil
 }
 
+func (repo *NewsRepository) FindNewsDueForPublish(db database.Database, now time.Time) ([]*entity.News, error) {
+	var news []*entity.News
+	result := db.GetDB().Where("status = ? AND publish_at <= ?", enum.NewsStatusScheduled, now).Find(&news)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return news, nil
+}
+
+func (repo *NewsRepository) FindNewsDueForUnpublish(db database.Database, now time.Time) ([]*entity.News, error) {
+	var news []*entity.News
+	result := db.GetDB().Where("status = ? AND unpublish_at <= ?", enum.NewsStatusActive, now).Find(&news)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return news, nil
+}
+
 func (repo *NewsRepository) UpdateNews(db database.Database, news *entity.News) error {
 	return db.GetDB().Save(&news).Error
 }
@@ -76,6 +185,15 @@ func (repo *NewsRepository) FindNewsMediaByID(db database.Database, mediaID, new
 	return &media, nil
 }
 
+func (repo *NewsRepository) FindNewsMedia(db database.Database, newsID uint, ownerType string) ([]*entity.Media, error) {
+	var media []*entity.Media
+	result := db.GetDB().Where("owner_id = ? AND owner_type = ?", newsID, ownerType).Find(&media)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return media, nil
+}
+
 func (repo *NewsRepository) CreateMedia(db database.Database, media *entity.Media) error {
 	return db.GetDB().Create(&media).Error
 }
@@ -83,3 +201,316 @@ func (repo *NewsRepository) CreateMedia(db database.Database, media *entity.Medi
 func (repo *NewsRepository) DeleteMedia(db database.Database, mediaID uint) error {
 	return db.GetDB().Delete(&entity.Media{}, mediaID).Error
 }
+
+func (repo *NewsRepository) CreateNewsCategory(db database.Database, category *entity.NewsCategory) error {
+	return db.GetDB().Create(&category).Error
+}
+
+func (repo *NewsRepository) UpdateNewsCategory(db database.Database, category *entity.NewsCategory) error {
+	return db.GetDB().Save(&category).Error
+}
+
+func (repo *NewsRepository) DeleteNewsCategory(db database.Database, categoryID uint) error {
+	return db.GetDB().Unscoped().Delete(&entity.NewsCategory{}, categoryID).Error
+}
+
+func (repo *NewsRepository) FindNewsCategoryByID(db database.Database, categoryID uint) (*entity.NewsCategory, error) {
+	var category entity.NewsCategory
+	result := db.GetDB().First(&category, categoryID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &category, nil
+}
+
+func (repo *NewsRepository) FindNewsCategoryByName(db database.Database, name, slug string) (*entity.NewsCategory, error) {
+	var category entity.NewsCategory
+	result := db.GetDB().Where("name = ? OR slug = ?", name, slug).First(&category)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &category, nil
+}
+
+func (repo *NewsRepository) FindNewsCategories(db database.Database) ([]*entity.NewsCategory, error) {
+	var categories []*entity.NewsCategory
+	result := db.GetDB().Order("name").Find(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return categories, nil
+}
+
+func (repo *NewsRepository) FindNewsCategoriesByIDs(db database.Database, categoryIDs []uint) ([]entity.NewsCategory, error) {
+	var categories []entity.NewsCategory
+	result := db.GetDB().Where("id IN ?", categoryIDs).Find(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return categories, nil
+}
+
+func (repo *NewsRepository) FindNewsCategoriesByNewsID(db database.Database, newsID uint) ([]entity.NewsCategory, error) {
+	var categories []entity.NewsCategory
+	result := db.GetDB().
+		Joins("JOIN news_category_assignments ON news_categories.id = news_category_assignments.news_category_id").
+		Where("news_category_assignments.news_id = ?", newsID).
+		Order("news_categories.name").
+		Find(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return categories, nil
+}
+
+func (repo *NewsRepository) ReplaceNewsCategories(db database.Database, news *entity.News, categories []entity.NewsCategory) error {
+	return db.GetDB().Model(&news).Association("Categories").Replace(categories)
+}
+
+func (repo *NewsRepository) CreateNewsTag(db database.Database, tag *entity.NewsTag) error {
+	return db.GetDB().Create(&tag).Error
+}
+
+func (repo *NewsRepository) FindNewsTagsBySlugs(db database.Database, slugs []string) ([]entity.NewsTag, error) {
+	var tags []entity.NewsTag
+	result := db.GetDB().Where("slug IN ?", slugs).Find(&tags)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return tags, nil
+}
+
+func (repo *NewsRepository) FindNewsTagsByNewsID(db database.Database, newsID uint) ([]entity.NewsTag, error) {
+	var tags []entity.NewsTag
+	result := db.GetDB().
+		Joins("JOIN news_tag_assignments ON news_tags.id = news_tag_assignments.news_tag_id").
+		Where("news_tag_assignments.news_id = ?", newsID).
+		Order("news_tags.name").
+		Find(&tags)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return tags, nil
+}
+
+func (repo *NewsRepository) ReplaceNewsTags(db database.Database, news *entity.News, tags []entity.NewsTag) error {
+	return db.GetDB().Model(&news).Association("Tags").Replace(tags)
+}
+
+func (repo *NewsRepository) FindNewsTagUsages(db database.Database, limit int) ([]repository.NewsTagUsage, error) {
+	var usages []repository.NewsTagUsage
+	result := db.GetDB().Model(&entity.NewsTag{}).
+		Select("news_tags.id, news_tags.name, news_tags.slug, COUNT(news.id) AS news_count").
+		Joins("JOIN news_tag_assignments ON news_tags.id = news_tag_assignments.news_tag_id").
+		Joins("JOIN news ON news.id = news_tag_assignments.news_id AND news.deleted_at IS NULL").
+		Where("news.status = ?", enum.NewsStatusActive).
+		Group("news_tags.id").
+		Order("news_count DESC, news_tags.name").
+		Limit(limit).
+		Scan(&usages)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return usages, nil
+}
+
+func (repo *NewsRepository) FindRelatedNews(db database.Database, newsID uint, tagIDs []uint, limit int) ([]*entity.News, error) {
+	var news []*entity.News
+	result := db.GetDB().
+		Joins("JOIN news_tag_assignments ON news.id = news_tag_assignments.news_id").
+		Where("news_tag_assignments.news_tag_id IN ? AND news.id <> ? AND news.status = ?", tagIDs, newsID, enum.NewsStatusActive).
+		Group("news.id").
+		Order("COUNT(news_tag_assignments.news_tag_id) DESC, COALESCE(news.published_at, news.created_at) DESC").
+		Limit(limit).
+		Find(&news)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return news, nil
+}
+
+func (repo *NewsRepository) CreateNewsRevision(db database.Database, revision *entity.NewsRevision) error {
+	return db.GetDB().Create(&revision).Error
+}
+
+func (repo *NewsRepository) FindNewsRevisionByID(db database.Database, revisionID, newsID uint) (*entity.NewsRevision, error) {
+	var revision entity.NewsRevision
+	result := db.GetDB().Where("id = ? AND news_id = ?", revisionID, newsID).First(&revision)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &revision, nil
+}
+
+func (repo *NewsRepository) FindLatestNewsRevision(db database.Database, newsID uint) (*entity.NewsRevision, error) {
+	var revision entity.NewsRevision
+	result := db.GetDB().Where("news_id = ?", newsID).Order("number DESC").First(&revision)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &revision, nil
+}
+
+func (repo *NewsRepository) FindNewsRevisions(db database.Database, newsID uint, opts ...repository.QueryModifier) ([]*entity.NewsRevision, error) {
+	var revisions []*entity.NewsRevision
+	query := db.GetDB().Where("news_id = ?", newsID)
+	for _, opt := range opts {
+		query = opt.Apply(query).(*gorm.DB)
+	}
+	result := query.Find(&revisions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return revisions, nil
+}
+
+func (repo *NewsRepository) IncrementNewsDailyViews(db database.Database, newsID uint, day time.Time, views int64) error {
+	dailyView := entity.NewsDailyView{
+		NewsID: newsID,
+		Day:    day,
+		Views:  views,
+	}
+	return db.GetDB().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "news_id"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"views":      gorm.Expr("news_daily_views.views + EXCLUDED.views"),
+			"updated_at": time.Now(),
+		}),
+	}).Create(&dailyView).Error
+}
+
+func (repo *NewsRepository) SumNewsViews(db database.Database, newsID uint) (int64, error) {
+	var views int64
+	result := db.GetDB().Model(&entity.NewsDailyView{}).
+		Select("COALESCE(SUM(views), 0)").
+		Where("news_id = ?", newsID).
+		Scan(&views)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return views, nil
+}
+
+func (repo *NewsRepository) FindNewsDailyViews(db database.Database, newsID uint, since time.Time) ([]repository.DailyCount, error) {
+	var counts []repository.DailyCount
+	result := db.GetDB().Model(&entity.NewsDailyView{}).
+		Select("day, views AS count").
+		Where("news_id = ? AND day >= ?", newsID, since).
+		Order("day").
+		Scan(&counts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return counts, nil
+}
+
+func (repo *NewsRepository) CountNewsLikes(db database.Database, newsID uint, ownerType string) (int64, error) {
+	var count int64
+	result := db.GetDB().Model(&entity.Like{}).Where("owner_id = ? AND owner_type = ?", newsID, ownerType).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (repo *NewsRepository) FindNewsDailyLikes(db database.Database, newsID uint, ownerType string, since time.Time) ([]repository.DailyCount, error) {
+	var counts []repository.DailyCount
+	result := db.GetDB().Model(&entity.Like{}).
+		Select("DATE(created_at) AS day, COUNT(*) AS count").
+		Where("owner_id = ? AND owner_type = ? AND created_at >= ?", newsID, ownerType, since).
+		Group("DATE(created_at)").
+		Order("day").
+		Scan(&counts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return counts, nil
+}
+
+func (repo *NewsRepository) FindMostViewedNews(db database.Database, since time.Time, limit int) ([]*entity.News, error) {
+	var news []*entity.News
+	result := db.GetDB().
+		Joins("JOIN news_daily_views ON news.id = news_daily_views.news_id").
+		Where("news_daily_views.day >= ? AND news.status = ?", since, enum.NewsStatusActive).
+		Group("news.id").
+		Order("SUM(news_daily_views.views) DESC, news.created_at DESC").
+		Limit(limit).
+		Find(&news)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return news, nil
+}
+
+func (repo *NewsRepository) CreateNewsPreviewToken(db database.Database, previewToken *entity.NewsPreviewToken) error {
+	return db.GetDB().Create(&previewToken).Error
+}
+
+func (repo *NewsRepository) UpdateNewsPreviewToken(db database.Database, previewToken *entity.NewsPreviewToken) error {
+	return db.GetDB().Save(&previewToken).Error
+}
+
+func (repo *NewsRepository) FindNewsPreviewTokenByID(db database.Database, previewTokenID uint) (*entity.NewsPreviewToken, error) {
+	var previewToken entity.NewsPreviewToken
+	result := db.GetDB().First(&previewToken, previewTokenID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &previewToken, nil
+}
+
+func (repo *NewsRepository) FindActiveNewsPreviewTokens(db database.Database, newsID uint, now time.Time) ([]*entity.NewsPreviewToken, error) {
+	var previewTokens []*entity.NewsPreviewToken
+	result := db.GetDB().
+		Where("news_id = ? AND revoked_at IS NULL AND expires_at > ?", newsID, now).
+		Order("created_at DESC").
+		Find(&previewTokens)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return previewTokens, nil
+}
+
+func (repo *NewsRepository) FindNewsTranslation(db database.Database, newsID uint, locale string) (*entity.NewsTranslation, error) {
+	var translation entity.NewsTranslation
+	result := db.GetDB().Where("news_id = ? AND locale = ?", newsID, locale).First(&translation)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &translation, nil
+}
+
+func (repo *NewsRepository) FindNewsTranslations(db database.Database, newsID uint) ([]*entity.NewsTranslation, error) {
+	var translations []*entity.NewsTranslation
+	result := db.GetDB().Where("news_id = ?", newsID).Order("locale").Find(&translations)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return translations, nil
+}
+
+func (repo *NewsRepository) SaveNewsTranslation(db database.Database, translation *entity.NewsTranslation) error {
+	return db.GetDB().Save(&translation).Error
+}
+
+func (repo *NewsRepository) DeleteNewsTranslation(db database.Database, translationID uint) error {
+	return db.GetDB().Unscoped().Delete(&entity.NewsTranslation{}, translationID).Error
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	repository "github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type PendingUploadRepository struct{}
+
+func NewPendingUploadRepository() *PendingUploadRepository {
+	return &PendingUploadRepository{}
+}
+
+func (repo *PendingUploadRepository) CreatePendingUpload(db database.Database, pendingUpload *entity.PendingUpload) error {
+	return db.GetDB().Create(&pendingUpload).Error
+}
+
+func (repo *PendingUploadRepository) FindPendingUploadByID(db database.Database, uploadID uint) (*entity.PendingUpload, error) {
+	var pendingUpload entity.PendingUpload
+	result := db.GetDB().First(&pendingUpload, uploadID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &pendingUpload, nil
+}
+
+func (repo *PendingUploadRepository) FindExpiredPendingUploads(db database.Database, now time.Time, opts ...repository.QueryModifier) ([]*entity.PendingUpload, error) {
+	var pendingUploads []*entity.PendingUpload
+	query := db.GetDB().Where("expires_at < ?", now)
+	for _, opt := range opts {
+		query = opt.Apply(query).(*gorm.DB)
+	}
+	result := query.Find(&pendingUploads)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return pendingUploads, nil
+}
+
+// DeletePendingUpload reports whether the upload was still there to delete, so
+// callers racing over the same upload can tell which one claimed it.
+func (repo *PendingUploadRepository) DeletePendingUpload(db database.Database, uploadID uint) (bool, error) {
+	result := db.GetDB().Unscoped().Delete(&entity.PendingUpload{}, uploadID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (repo *PendingUploadRepository) DeleteExpiredPendingUpload(db database.Database, uploadID uint, now time.Time) (bool, error) {
+	result := db.GetDB().Unscoped().Where("expires_at < ?", now).Delete(&entity.PendingUpload{}, uploadID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/entity"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	repository "github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReferralRepository struct{}
+
+func NewReferralRepository() *ReferralRepository {
+	return &ReferralRepository{}
+}
+
+func (repo *ReferralRepository) CreateReferral(db database.Database, referral *entity.Referral) error {
+	return db.GetDB().Create(&referral).Error
+}
+
+func (repo *ReferralRepository) UpdateReferral(db database.Database, referral *entity.Referral) error {
+	return db.GetDB().Save(&referral).Error
+}
+
+func (repo *ReferralRepository) FindReferralByRefereeID(db database.Database, refereeID uint) (*entity.Referral, error) {
+	var referral entity.Referral
+	result := db.GetDB().Where("referee_id = ?", refereeID).First(&referral)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &referral, nil
+}
+
+func (repo *ReferralRepository) FindReferralByRefereeIDForUpdate(db database.Database, refereeID uint) (*entity.Referral, error) {
+	var referral entity.Referral
+	result := db.GetDB().Clauses(clause.Locking{Strength: "UPDATE"}).Where("referee_id = ?", refereeID).First(&referral)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &referral, nil
+}
+
+func (repo *ReferralRepository) FindReferralsByStatus(db database.Database, statuses []enum.ReferralStatus, opts ...repository.QueryModifier) ([]*entity.Referral, error) {
+	var referrals []*entity.Referral
+	query := db.GetDB().Where("status IN ?", statuses)
+	for _, opt := range opts {
+		query = opt.Apply(query).(*gorm.DB)
+	}
+	result := query.Find(&referrals)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return referrals, nil
+}
+
+func (repo *ReferralRepository) FindReferralByIDForUpdate(db database.Database, referralID uint) (*entity.Referral, error) {
+	var referral entity.Referral
+	result := db.GetDB().Clauses(clause.Locking{Strength: "UPDATE"}).First(&referral, referralID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &referral, nil
+}
+
+func (repo *ReferralRepository) CountReferralsByIP(db database.Database, registrationIP string, since time.Time) (int64, error) {
+	var count int64
+	result := db.GetDB().Model(&entity.Referral{}).
+		Where("registration_ip = ? AND created_at >= ?", registrationIP, since).
+		Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (repo *ReferralRepository) CountReferralsByDeviceID(db database.Database, deviceID string) (int64, error) {
+	var count int64
+	result := db.GetDB().Model(&entity.Referral{}).Where("device_id = ?", deviceID).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (repo *ReferralRepository) CountReferrerReferrals(db database.Database, referrerID uint, statuses []enum.ReferralStatus) (int64, error) {
+	var count int64
+	result := db.GetDB().Model(&entity.Referral{}).
+		Where("referrer_id = ? AND status IN ?", referrerID, statuses).
+		Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (repo *ReferralRepository) CountReferralsByStatus(db database.Database, status enum.ReferralStatus) (int64, error) {
+	var count int64
+	result := db.GetDB().Model(&entity.Referral{}).Where("status = ?", status).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (repo *ReferralRepository) SumConvertedRewards(db database.Database) (uint, error) {
+	var total uint
+	result := db.GetDB().Model(&entity.Referral{}).
+		Where("status = ?", enum.ReferralStatusConverted).
+		Select("COALESCE(SUM(referrer_reward + referee_reward), 0)").
+		Scan(&total)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return total, nil
+}
+
+func (repo *ReferralRepository) CountRewardedAddresses(db database.Database, address *entity.Address, excludedOwnerID uint) (int64, error) {
+	var count int64
+	result := db.GetDB().Model(&entity.Address{}).
+		Joins("JOIN referrals ON addresses.owner_id IN (referrals.referrer_id, referrals.referee_id)").
+		Where("referrals.status = ? AND referrals.deleted_at IS NULL", enum.ReferralStatusConverted).
+		Where("addresses.owner_type = ? AND addresses.owner_id <> ?", address.OwnerType, excludedOwnerID).
+		Where("addresses.postal_code = ? AND addresses.house_number = ? AND addresses.unit = ?", address.PostalCode, address.HouseNumber, address.Unit).
+		Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
@@ -35,6 +35,15 @@ func (repo *UserRepository) FindUserByID(db database.Database, id uint) (*entity
 	return &user, nil
 }
 
+func (repo *UserRepository) FindUsersByIDs(db database.Database, ids []uint) ([]*entity.User, error) {
+	var users []*entity.User
+	result := db.GetDB().Where("id IN ?", ids).Find(&users)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return users, nil
+}
+
 func (repo *UserRepository) FindUserByStatus(db database.Database, statuses []enum.UserStatus, opts ...repository.QueryModifier) ([]*entity.User, error) {
 	var users []*entity.User
 	query := db.GetDB().Where("status IN ?", statuses)
@@ -63,6 +72,18 @@ func (repo *UserRepository) FindUserByEmail(db database.Database, email string)
 	return &user, nil
 }
 
+func (repo *UserRepository) FindUserByReferralCode(db database.Database, code string) (*entity.User, error) {
+	var user entity.User
+	result := db.GetDB().Where("referral_code = ?", code).First(&user)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &user, nil
+}
+
 func (repo *UserRepository) FindUserByPhone(db database.Database, phone string) (*entity.User, error) {
 	var user entity.User
 	result := db.GetDB().Where("phone = ?", phone).First(&user)
@@ -99,6 +120,16 @@ func (repo *UserRepository) UpdateUser(db database.Database, user *entity.User)
 	return db.GetDB().Save(&user).Error
 }
 
+// UpdateUserReferralCode only sets a code on users without one, so a code that
+// was already handed out is never replaced.
+func (repo *UserRepository) UpdateUserReferralCode(db database.Database, userID uint, code string) error {
+	return db.GetDB().Model(&entity.User{}).Where("id = ? AND referral_code IS NULL", userID).UpdateColumn("referral_code", code).Error
+}
+
+func (repo *UserRepository) AddUserRewardPoints(db database.Database, userID uint, points uint) error {
+	return db.GetDB().Model(&entity.User{}).Where("id = ?", userID).UpdateColumn("reward_points", gorm.Expr("reward_points + ?", points)).Error
+}
+
 func (repo *UserRepository) FindUserRoles(db database.Database, user *entity.User) error {
 	return db.GetDB().Preload("Roles").First(&user).Error
 }
@@ -0,0 +1,38 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"github.com/redis/go-redis/v9"
+)
+
+type FeedCacheRepository struct {
+	rdb database.Cache
+}
+
+func NewFeedCacheRepository(rdb database.Cache) *FeedCacheRepository {
+	return &FeedCacheRepository{
+		rdb: rdb,
+	}
+}
+
+func (feedCache *FeedCacheRepository) Get(ctx context.Context, key string) ([]byte, error) {
+	document, err := feedCache.rdb.GetRDB().Get(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return document, nil
+}
+
+func (feedCache *FeedCacheRepository) Set(ctx context.Context, key string, document []byte, expiration time.Duration) error {
+	return feedCache.rdb.GetRDB().Set(ctx, key, document, expiration).Err()
+}
+
+func (feedCache *FeedCacheRepository) Delete(ctx context.Context, keys ...string) error {
+	return feedCache.rdb.GetRDB().Del(ctx, keys...).Err()
+}
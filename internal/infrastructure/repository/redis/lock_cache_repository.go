@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"github.com/redis/go-redis/v9"
+)
+
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type LockCacheRepository struct {
+	rdb database.Cache
+}
+
+func NewLockCacheRepository(rdb database.Cache) *LockCacheRepository {
+	return &LockCacheRepository{
+		rdb: rdb,
+	}
+}
+
+// AcquireLock returns a token identifying the holder, or an empty token when the
+// lock is already held elsewhere.
+func (lockCache *LockCacheRepository) AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	tokenBytes := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, tokenBytes); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	acquired, err := lockCache.rdb.GetRDB().SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return "", err
+	}
+	if !acquired {
+		return "", nil
+	}
+	return token, nil
+}
+
+func (lockCache *LockCacheRepository) ReleaseLock(ctx context.Context, key, token string) error {
+	return releaseLockScript.Run(ctx, lockCache.rdb.GetRDB(), []string{key}, token).Err()
+}
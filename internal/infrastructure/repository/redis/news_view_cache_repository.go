@@ -0,0 +1,54 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"github.com/redis/go-redis/v9"
+)
+
+var popHashScript = redis.NewScript(`
+local values = redis.call("HGETALL", KEYS[1])
+redis.call("DEL", KEYS[1])
+return values
+`)
+
+type NewsViewCacheRepository struct {
+	rdb database.Cache
+}
+
+func NewNewsViewCacheRepository(rdb database.Cache) *NewsViewCacheRepository {
+	return &NewsViewCacheRepository{
+		rdb: rdb,
+	}
+}
+
+// MarkViewed reports whether this is the first view for the key within the window.
+func (newsViewCache *NewsViewCacheRepository) MarkViewed(ctx context.Context, key string, window time.Duration) (bool, error) {
+	return newsViewCache.rdb.GetRDB().SetNX(ctx, key, 1, window).Result()
+}
+
+func (newsViewCache *NewsViewCacheRepository) IncrementPendingViews(ctx context.Context, key, field string, views int64) error {
+	return newsViewCache.rdb.GetRDB().HIncrBy(ctx, key, field, views).Err()
+}
+
+// PopPendingViews reads and clears the buffered counters in one step, so views
+// counted while a flush is running are kept for the next one.
+func (newsViewCache *NewsViewCacheRepository) PopPendingViews(ctx context.Context, key string) (map[string]int64, error) {
+	values, err := popHashScript.Run(ctx, newsViewCache.rdb.GetRDB(), []string{key}).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(map[string]int64, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		views, err := strconv.ParseInt(values[i+1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		pending[values[i]] = views
+	}
+	return pending, nil
+}
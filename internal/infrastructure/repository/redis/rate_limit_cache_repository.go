@@ -0,0 +1,33 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"github.com/redis/go-redis/v9"
+)
+
+// incrementScript sets the expiry whenever the key has none, so a counter can
+// never outlive its window, even one left behind by an older client.
+var incrementScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+type RateLimitCacheRepository struct {
+	rdb database.Cache
+}
+
+func NewRateLimitCacheRepository(rdb database.Cache) *RateLimitCacheRepository {
+	return &RateLimitCacheRepository{
+		rdb: rdb,
+	}
+}
+
+func (rateLimitCache *RateLimitCacheRepository) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return incrementScript.Run(ctx, rateLimitCache.rdb.GetRDB(), []string{key}, window.Milliseconds()).Int64()
+}
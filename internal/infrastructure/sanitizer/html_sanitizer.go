@@ -0,0 +1,208 @@
+package sanitizer
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/CosmeticsShiraz/Backend/internal/domain/sanitizer"
+	"golang.org/x/net/html"
+)
+
+// AllowlistSanitizer keeps only the tags, attributes and URL schemes the news
+// editor is expected to produce. Unknown tags are unwrapped and their text
+// kept, while tags that carry active content are dropped together with it.
+type AllowlistSanitizer struct {
+	allowedTags    map[string]map[string]bool
+	globalAttrs    map[string]bool
+	urlAttrs       map[string]bool
+	allowedSchemes map[string]bool
+	droppedTags    map[string]bool
+	voidTags       map[string]bool
+	blockTags      map[string]bool
+}
+
+func NewAllowlistSanitizer() *AllowlistSanitizer {
+	return &AllowlistSanitizer{
+		allowedTags: map[string]map[string]bool{
+			"p": {}, "br": {}, "hr": {}, "div": {}, "span": {},
+			"h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {}, "h6": {},
+			"strong": {}, "b": {}, "em": {}, "i": {}, "u": {}, "s": {}, "sub": {}, "sup": {},
+			"blockquote": {}, "code": {}, "pre": {},
+			"ul": {}, "ol": {"start": true}, "li": {},
+			"a":      {"href": true, "title": true, "target": true},
+			"img":    {"src": true, "alt": true, "title": true, "width": true, "height": true},
+			"figure": {}, "figcaption": {},
+			"video":  {"src": true, "poster": true, "controls": true, "width": true, "height": true},
+			"audio":  {"src": true, "controls": true},
+			"source": {"src": true, "type": true},
+			"table":  {}, "thead": {}, "tbody": {}, "tr": {},
+			"th": {"colspan": true, "rowspan": true},
+			"td": {"colspan": true, "rowspan": true},
+		},
+		globalAttrs:    map[string]bool{"dir": true, "lang": true},
+		urlAttrs:       map[string]bool{"href": true, "src": true, "poster": true},
+		allowedSchemes: map[string]bool{"": true, "http": true, "https": true, "mailto": true, "media": true},
+		droppedTags: map[string]bool{
+			"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+			"noscript": true, "template": true, "textarea": true, "select": true, "title": true,
+		},
+		voidTags: map[string]bool{
+			"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+			"input": true, "link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+		},
+		blockTags: map[string]bool{
+			"p": true, "br": true, "div": true, "li": true, "blockquote": true, "pre": true,
+			"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "tr": true, "figcaption": true,
+		},
+	}
+}
+
+func (htmlSanitizer *AllowlistSanitizer) isSafeURL(value string) bool {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "//") {
+		return false
+	}
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	return htmlSanitizer.allowedSchemes[strings.ToLower(parsed.Scheme)]
+}
+
+func (htmlSanitizer *AllowlistSanitizer) sanitizeAttrs(tag string, attrs []html.Attribute, rewrite sanitizer.URLRewriter) []html.Attribute {
+	allowedAttrs := htmlSanitizer.allowedTags[tag]
+	sanitized := make([]html.Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		name := strings.ToLower(attr.Key)
+		if attr.Namespace != "" || (!allowedAttrs[name] && !htmlSanitizer.globalAttrs[name]) {
+			continue
+		}
+		value := attr.Val
+		if htmlSanitizer.urlAttrs[name] {
+			if !htmlSanitizer.isSafeURL(value) {
+				continue
+			}
+			if rewrite != nil {
+				var keep bool
+				value, keep = rewrite(tag, name, strings.TrimSpace(value))
+				if !keep {
+					continue
+				}
+			}
+		}
+		if name == "target" && value != "_blank" {
+			continue
+		}
+		sanitized = append(sanitized, html.Attribute{Key: name, Val: value})
+	}
+	if tag == "a" {
+		sanitized = append(sanitized, html.Attribute{Key: "rel", Val: "noopener noreferrer nofollow"})
+	}
+	return sanitized
+}
+
+// walk calls visit for every token that is not inside a dropped tag. Void tags
+// such as embed are dropped on their own. A dropped tag left unclosed ends with
+// its parent, or with the input, so it never swallows the rest of the document.
+func (htmlSanitizer *AllowlistSanitizer) walk(input string, visit func(tokenType html.TokenType, token html.Token, tag string)) {
+	tokenizer := html.NewTokenizer(strings.NewReader(input))
+	var openTags []string
+	droppedDepth := 0
+	droppedTag := ""
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return
+		}
+		token := tokenizer.Token()
+		tag := strings.ToLower(token.Data)
+
+		if droppedDepth > 0 {
+			switch {
+			case tokenType == html.StartTagToken && tag == droppedTag:
+				droppedDepth++
+				continue
+			case tokenType == html.EndTagToken && tag == droppedTag:
+				droppedDepth--
+				continue
+			case tokenType == html.EndTagToken && lastIndex(openTags, tag) >= 0:
+				droppedDepth = 0
+			default:
+				continue
+			}
+		}
+
+		switch tokenType {
+		case html.StartTagToken:
+			if htmlSanitizer.droppedTags[tag] {
+				if !htmlSanitizer.voidTags[tag] {
+					droppedTag = tag
+					droppedDepth = 1
+				}
+				continue
+			}
+			if !htmlSanitizer.voidTags[tag] {
+				openTags = append(openTags, tag)
+			}
+		case html.SelfClosingTagToken:
+			if htmlSanitizer.droppedTags[tag] {
+				continue
+			}
+		case html.EndTagToken:
+			if htmlSanitizer.droppedTags[tag] {
+				continue
+			}
+			if i := lastIndex(openTags, tag); i >= 0 {
+				openTags = openTags[:i]
+			}
+		}
+		visit(tokenType, token, tag)
+	}
+}
+
+func lastIndex(tags []string, tag string) int {
+	for i := len(tags) - 1; i >= 0; i-- {
+		if tags[i] == tag {
+			return i
+		}
+	}
+	return -1
+}
+
+func (htmlSanitizer *AllowlistSanitizer) Sanitize(input string, rewrite sanitizer.URLRewriter) string {
+	var output strings.Builder
+	htmlSanitizer.walk(input, func(tokenType html.TokenType, token html.Token, tag string) {
+		switch tokenType {
+		case html.TextToken:
+			output.WriteString(html.EscapeString(token.Data))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if _, allowed := htmlSanitizer.allowedTags[tag]; !allowed {
+				return
+			}
+			token.Data = tag
+			token.Attr = htmlSanitizer.sanitizeAttrs(tag, token.Attr, rewrite)
+			output.WriteString(token.String())
+		case html.EndTagToken:
+			if _, allowed := htmlSanitizer.allowedTags[tag]; !allowed {
+				return
+			}
+			output.WriteString("</" + tag + ">")
+		}
+	})
+	return output.String()
+}
+
+func (htmlSanitizer *AllowlistSanitizer) PlainText(input string) string {
+	var output strings.Builder
+	htmlSanitizer.walk(input, func(tokenType html.TokenType, token html.Token, tag string) {
+		switch tokenType {
+		case html.TextToken:
+			output.WriteString(token.Data)
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			if htmlSanitizer.blockTags[tag] {
+				output.WriteString(" ")
+			}
+		}
+	})
+	return strings.Join(strings.Fields(output.String()), " ")
+}
@@ -0,0 +1,129 @@
+package sanitizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowlistSanitizer_Sanitize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "keeps allowed markup",
+			input:    `<p dir="rtl">hello <strong>world</strong></p>`,
+			expected: `<p dir="rtl">hello <strong>world</strong></p>`,
+		},
+		{
+			name:     "drops script with its content",
+			input:    `<p>a</p><script>alert(1)</script><p>b</p>`,
+			expected: `<p>a</p><p>b</p>`,
+		},
+		{
+			name:     "unwraps unknown tags",
+			input:    `<p><font color="red">text</font></p>`,
+			expected: `<p>text</p>`,
+		},
+		{
+			name:     "drops event handlers and unsafe urls",
+			input:    `<a href="javascript:alert(1)" onclick="x()">link</a><img src="//evil.com/x.png" onerror="x()">`,
+			expected: `<a rel="noopener noreferrer nofollow">link</a><img>`,
+		},
+		{
+			name:     "keeps only blank target",
+			input:    `<a href="https://example.com" target="_top">link</a>`,
+			expected: `<a href="https://example.com" rel="noopener noreferrer nofollow">link</a>`,
+		},
+		{
+			name:     "void dropped tag does not swallow the rest",
+			input:    `<p>intro</p><embed src="https://example.com/x.swf"><p>rest of the article</p>`,
+			expected: `<p>intro</p><p>rest of the article</p>`,
+		},
+		{
+			name:     "unclosed object ends with its parent",
+			input:    `<div><p>intro</p><object data="x.swf"><param name="a" value="b"></div><p>rest</p>`,
+			expected: `<div><p>intro</p></div><p>rest</p>`,
+		},
+		{
+			name:     "unclosed select ends with its parent",
+			input:    `<p>intro<select><option>a</option></p><p>rest</p>`,
+			expected: `<p>intro</p><p>rest</p>`,
+		},
+		{
+			name:     "unclosed template ends with the input",
+			input:    `<p>intro</p><template><p>hidden</p>`,
+			expected: `<p>intro</p>`,
+		},
+		{
+			name:     "nested dropped tags",
+			input:    `<object><object>a</object>b</object><p>rest</p>`,
+			expected: `<p>rest</p>`,
+		},
+		{
+			name:     "stray dropped end tag",
+			input:    `<p>a</object>b</p>`,
+			expected: `<p>ab</p>`,
+		},
+	}
+
+	htmlSanitizer := NewAllowlistSanitizer()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, htmlSanitizer.Sanitize(test.input, nil))
+		})
+	}
+}
+
+func TestAllowlistSanitizer_SanitizeRewrite(t *testing.T) {
+	htmlSanitizer := NewAllowlistSanitizer()
+	rewrite := func(tag, attribute, value string) (string, bool) {
+		if strings.HasPrefix(value, "https://cdn.example.com/") {
+			return "media:1", true
+		}
+		return value, !strings.HasPrefix(value, "https://blocked.example.com/")
+	}
+
+	input := `<img src="https://cdn.example.com/a.png"><img src="https://blocked.example.com/b.png"><a href="https://example.com">x</a>`
+	expected := `<img src="media:1"><img><a href="https://example.com" rel="noopener noreferrer nofollow">x</a>`
+	assert.Equal(t, expected, htmlSanitizer.Sanitize(input, rewrite))
+}
+
+func TestAllowlistSanitizer_PlainText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "separates blocks",
+			input:    `<h1>Title</h1><p>first</p><p>second <b>bold</b></p>`,
+			expected: "Title first second bold",
+		},
+		{
+			name:     "drops script and style",
+			input:    `<style>p{}</style><p>text</p><script>x()</script>`,
+			expected: "text",
+		},
+		{
+			name:     "void dropped tag does not swallow the rest",
+			input:    `<p>intro</p><embed src="x.swf"><p>rest</p>`,
+			expected: "intro rest",
+		},
+		{
+			name:     "unclosed object ends with its parent",
+			input:    `<div>intro<object>hidden</div><p>rest</p>`,
+			expected: "intro rest",
+		},
+	}
+
+	htmlSanitizer := NewAllowlistSanitizer()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, htmlSanitizer.PlainText(test.input))
+		})
+	}
+}
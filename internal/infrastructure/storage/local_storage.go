@@ -0,0 +1,242 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	domainS3 "github.com/CosmeticsShiraz/Backend/internal/domain/s3"
+)
+
+// LocalStorage keeps objects on the local filesystem, one directory per bucket,
+// and hands out HMAC-signed URLs that the app serves itself under /v1/storage.
+type LocalStorage struct {
+	storage *bootstrap.S3
+	buckets map[enum.BucketType]string
+}
+
+func NewLocalStorage(storage *bootstrap.S3) *LocalStorage {
+	buckets := make(map[enum.BucketType]string)
+	buckets[enum.VATTaxpayerCertificate] = storage.Buckets.VATTaxpayerCertificate
+	buckets[enum.OfficialNewspaperAD] = storage.Buckets.OfficialNewspaperAD
+	buckets[enum.ProfilePic] = storage.Buckets.ProfilePic
+	buckets[enum.LogoPic] = storage.Buckets.LogoPic
+	buckets[enum.NewsMedia] = storage.Buckets.NewsMedia
+	return &LocalStorage{
+		storage: storage,
+		buckets: buckets,
+	}
+}
+
+func (localStorage *LocalStorage) getBucket(bucketType enum.BucketType) (string, error) {
+	bucket, ok := localStorage.buckets[bucketType]
+	if !ok || bucket == "" {
+		return "", fmt.Errorf("bucket not exist")
+	}
+	return bucket, nil
+}
+
+func (localStorage *LocalStorage) isKnownBucket(bucket string) bool {
+	for _, name := range localStorage.buckets {
+		if name != "" && name == bucket {
+			return true
+		}
+	}
+	return false
+}
+
+func (localStorage *LocalStorage) objectPath(bucket, key string) (string, error) {
+	if !localStorage.isKnownBucket(bucket) {
+		return "", fmt.Errorf("bucket not exist")
+	}
+	root := filepath.Join(localStorage.storage.LocalPath, bucket)
+	objectPath := filepath.Join(root, filepath.FromSlash(key))
+	if !strings.HasPrefix(objectPath, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return objectPath, nil
+}
+
+func (localStorage *LocalStorage) write(bucket, key string, body io.Reader) error {
+	objectPath, err := localStorage.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(objectPath), 0o755); err != nil {
+		return fmt.Errorf("unable to create directory for %q, %w", key, err)
+	}
+
+	file, err := os.Create(objectPath)
+	if err != nil {
+		return fmt.Errorf("unable to create %q in %q, %w", key, bucket, err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, body); err != nil {
+		return fmt.Errorf("unable to write %q to %q, %w", key, bucket, err)
+	}
+	return nil
+}
+
+func (localStorage *LocalStorage) sign(method, bucket, key, contentType string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(localStorage.storage.SigningKey))
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%s\n%d", method, bucket, key, contentType, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (localStorage *LocalStorage) signedURL(method, bucket, key, contentType string, expiration time.Duration) (string, error) {
+	if localStorage.storage.SigningKey == "" {
+		return "", fmt.Errorf("storage signing key is not set")
+	}
+	expires := time.Now().Add(expiration).Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", localStorage.sign(method, bucket, key, contentType, expires))
+
+	objectURL := fmt.Sprintf("%s/v1/storage/%s/%s", strings.TrimSuffix(localStorage.storage.PublicURL, "/"), url.PathEscape(bucket), escapeKey(key))
+	return objectURL + "?" + query.Encode(), nil
+}
+
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func (localStorage *LocalStorage) UploadObject(bucketType enum.BucketType, key string, file *multipart.FileHeader) error {
+	bucket, err := localStorage.getBucket(bucketType)
+	if err != nil {
+		return err
+	}
+
+	fileReader, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("unable to open file %q, %w", file.Filename, err)
+	}
+	defer fileReader.Close()
+
+	return localStorage.write(bucket, key, fileReader)
+}
+
+func (localStorage *LocalStorage) PutObject(bucketType enum.BucketType, key string, body []byte, contentType string) error {
+	bucket, err := localStorage.getBucket(bucketType)
+	if err != nil {
+		return err
+	}
+	return localStorage.write(bucket, key, bytes.NewReader(body))
+}
+
+func (localStorage *LocalStorage) GetObject(bucketType enum.BucketType, key string) ([]byte, error) {
+	bucket, err := localStorage.getBucket(bucketType)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := localStorage.ReadObject(bucket, key)
+	return data, err
+}
+
+func (localStorage *LocalStorage) HeadObject(bucketType enum.BucketType, key string) (*domainS3.ObjectInfo, error) {
+	bucket, err := localStorage.getBucket(bucketType)
+	if err != nil {
+		return nil, err
+	}
+	objectPath, err := localStorage.objectPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(objectPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to check object %q in %q, %w", key, bucket, err)
+	}
+
+	return &domainS3.ObjectInfo{
+		Size:        info.Size(),
+		ContentType: mime.TypeByExtension(filepath.Ext(key)),
+	}, nil
+}
+
+func (localStorage *LocalStorage) DeleteObject(bucketType enum.BucketType, key string) error {
+	bucket, err := localStorage.getBucket(bucketType)
+	if err != nil {
+		return err
+	}
+	objectPath, err := localStorage.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(objectPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to delete %q from %q, %w", key, bucket, err)
+	}
+	return nil
+}
+
+func (localStorage *LocalStorage) GetPresignedURL(bucketType enum.BucketType, objectKey string, expiration time.Duration) (string, error) {
+	bucket, err := localStorage.getBucket(bucketType)
+	if err != nil {
+		return "", err
+	}
+	return localStorage.signedURL(http.MethodGet, bucket, objectKey, "", expiration)
+}
+
+func (localStorage *LocalStorage) GetPresignedUploadURL(bucketType enum.BucketType, key, contentType string, size int64, expiration time.Duration) (string, error) {
+	bucket, err := localStorage.getBucket(bucketType)
+	if err != nil {
+		return "", err
+	}
+	return localStorage.signedURL(http.MethodPut, bucket, key, contentType, expiration)
+}
+
+func (localStorage *LocalStorage) VerifySignature(method, bucket, key, contentType string, expires int64, signature string) bool {
+	if localStorage.storage.SigningKey == "" || time.Now().Unix() > expires {
+		return false
+	}
+	expected := localStorage.sign(method, bucket, key, contentType, expires)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func (localStorage *LocalStorage) ReadObject(bucket, key string) ([]byte, string, error) {
+	objectPath, err := localStorage.objectPath(bucket, key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err := os.ReadFile(objectPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, "", nil
+		}
+		return nil, "", fmt.Errorf("unable to read %q from %q, %w", key, bucket, err)
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(key))
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+	return data, contentType, nil
+}
+
+func (localStorage *LocalStorage) WriteObject(bucket, key string, body []byte) error {
+	return localStorage.write(bucket, key, bytes.NewReader(body))
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage_ObjectPath(t *testing.T) {
+	root := t.TempDir()
+	localStorage := NewLocalStorage(&bootstrap.S3{
+		LocalPath: root,
+		Buckets:   bootstrap.BucketName{NewsMedia: "news", ProfilePic: "profile"},
+	})
+
+	tests := []struct {
+		name     string
+		bucket   string
+		key      string
+		expected string
+		valid    bool
+	}{
+		{name: "plain key", bucket: "news", key: "cover.png", expected: filepath.Join(root, "news", "cover.png"), valid: true},
+		{name: "nested key", bucket: "news", key: "1/media/a.webp", expected: filepath.Join(root, "news", "1", "media", "a.webp"), valid: true},
+		{name: "dot segments inside the bucket", bucket: "news", key: "1/../2/a.png", expected: filepath.Join(root, "news", "2", "a.png"), valid: true},
+		{name: "escapes the bucket", bucket: "news", key: "../profile/a.png", valid: false},
+		{name: "escapes the storage root", bucket: "news", key: "../../etc/passwd", valid: false},
+		{name: "deep traversal", bucket: "news", key: "1/../../../etc/passwd", valid: false},
+		{name: "absolute key stays inside", bucket: "news", key: "/etc/passwd", expected: filepath.Join(root, "news", "etc", "passwd"), valid: true},
+		{name: "bucket itself", bucket: "news", key: "", valid: false},
+		{name: "bucket through dot", bucket: "news", key: ".", valid: false},
+		{name: "sibling with bucket prefix", bucket: "news", key: "../news-private/a.png", valid: false},
+		{name: "unknown bucket", bucket: "secrets", key: "a.png", valid: false},
+		{name: "traversal in bucket", bucket: "../news", key: "a.png", valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objectPath, err := localStorage.objectPath(test.bucket, test.key)
+			if !test.valid {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, objectPath)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	domainS3 "github.com/CosmeticsShiraz/Backend/internal/domain/s3"
+)
+
+// NewObjectStorage picks the storage backend from STORAGE_DRIVER.
+func NewObjectStorage(
+	constants *bootstrap.Constants,
+	storage *bootstrap.S3,
+	s3Storage *S3Storage,
+	localStorage *LocalStorage,
+) domainS3.S3Storage {
+	if storage.Driver == constants.StorageDrivers.Local {
+		return localStorage
+	}
+	return s3Storage
+}
@@ -1,13 +1,18 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"mime/multipart"
+	"net/http"
+	"net/url"
 	"slices"
 	"time"
 
 	"github.com/CosmeticsShiraz/Backend/bootstrap"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	domainS3 "github.com/CosmeticsShiraz/Backend/internal/domain/s3"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -77,7 +82,129 @@ func (s3StorageS3Storage *S3Storage) UploadObject(bucketType enum.BucketType, ke
 	}
 	defer fileReader.Close()
 
-	_, err = s3StorageS3Storage.clients.HeadBucket(&s3.HeadBucketInput{
+	if err := s3StorageS3Storage.ensureBucket(bucket); err != nil {
+		return err
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(fileReader, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return fmt.Errorf("unable to read file %q, %w", file.Filename, err)
+	}
+	head = head[:n]
+
+	_, err = s3StorageS3Storage.uploader.Upload(&s3manager.UploadInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        io.MultiReader(bytes.NewReader(head), fileReader),
+		ContentType: aws.String(http.DetectContentType(head)),
+		Metadata: map[string]*string{
+			"Original-Filename": aws.String(url.PathEscape(file.Filename)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to upload %q to %q, %w", file.Filename, bucket, err)
+	}
+	return nil
+}
+
+func (s3StorageS3Storage *S3Storage) PutObject(bucketType enum.BucketType, key string, body []byte, contentType string) error {
+	err := s3StorageS3Storage.setS3Client(bucketType)
+	if err != nil {
+		return err
+	}
+	bucket := s3StorageS3Storage.buckets[bucketType]
+
+	if err := s3StorageS3Storage.ensureBucket(bucket); err != nil {
+		return err
+	}
+
+	_, err = s3StorageS3Storage.uploader.Upload(&s3manager.UploadInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to upload %q to %q, %w", key, bucket, err)
+	}
+	return nil
+}
+
+func (s3StorageS3Storage *S3Storage) GetObject(bucketType enum.BucketType, key string) ([]byte, error) {
+	err := s3StorageS3Storage.setS3Client(bucketType)
+	if err != nil {
+		return nil, err
+	}
+	bucket := s3StorageS3Storage.buckets[bucketType]
+
+	output, err := s3StorageS3Storage.clients.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %q from %q, %w", key, bucket, err)
+	}
+	defer output.Body.Close()
+
+	return io.ReadAll(output.Body)
+}
+
+func (s3StorageS3Storage *S3Storage) HeadObject(bucketType enum.BucketType, key string) (*domainS3.ObjectInfo, error) {
+	err := s3StorageS3Storage.setS3Client(bucketType)
+	if err != nil {
+		return nil, err
+	}
+	bucket := s3StorageS3Storage.buckets[bucketType]
+
+	output, err := s3StorageS3Storage.clients.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NotFound") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to check object %q in %q, %w", key, bucket, err)
+	}
+
+	return &domainS3.ObjectInfo{
+		Size:        aws.Int64Value(output.ContentLength),
+		ContentType: aws.StringValue(output.ContentType),
+	}, nil
+}
+
+// GetPresignedUploadURL signs a PUT request for the key. The content type and
+// length are part of the signature, so the client must send the same Content-Type
+// and Content-Length headers.
+func (s3StorageS3Storage *S3Storage) GetPresignedUploadURL(bucketType enum.BucketType, key, contentType string, size int64, expiration time.Duration) (string, error) {
+	err := s3StorageS3Storage.setS3Client(bucketType)
+	if err != nil {
+		return "", err
+	}
+	bucket := s3StorageS3Storage.buckets[bucketType]
+
+	if err := s3StorageS3Storage.ensureBucket(bucket); err != nil {
+		return "", err
+	}
+
+	req, _ := s3StorageS3Storage.clients.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:        aws.String(bucket),
+		Key:           aws.String(key),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(size),
+	})
+
+	url, err := req.Presign(expiration)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned upload URL: %w", err)
+	}
+
+	return url, nil
+}
+
+func (s3StorageS3Storage *S3Storage) ensureBucket(bucket string) error {
+	_, err := s3StorageS3Storage.clients.HeadBucket(&s3.HeadBucketInput{
 		Bucket: aws.String(bucket),
 	})
 
@@ -100,15 +227,6 @@ func (s3StorageS3Storage *S3Storage) UploadObject(bucketType enum.BucketType, ke
 			return fmt.Errorf("unable to check bucket %q, %w", bucket, err)
 		}
 	}
-
-	_, err = s3StorageS3Storage.uploader.Upload(&s3manager.UploadInput{
-		Bucket: aws.String(bucket),
-		Key:    aws.String(key),
-		Body:   fileReader,
-	})
-	if err != nil {
-		return fmt.Errorf("unable to upload %q to %q, %w", file.Filename, bucket, err)
-	}
 	return nil
 }
 
@@ -0,0 +1,45 @@
+package feed
+
+import (
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+type GeneralFeedController struct {
+	feedService usecase.FeedService
+}
+
+func NewGeneralFeedController(
+	feedService usecase.FeedService,
+) *GeneralFeedController {
+	return &GeneralFeedController{
+		feedService: feedService,
+	}
+}
+
+func (feedController *GeneralFeedController) GetNewsRSS(ctx *gin.Context) {
+	document, err := feedController.feedService.GetNewsRSS()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.Data(200, "application/rss+xml; charset=utf-8", document)
+}
+
+func (feedController *GeneralFeedController) GetNewsAtom(ctx *gin.Context) {
+	document, err := feedController.feedService.GetNewsAtom()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.Data(200, "application/atom+xml; charset=utf-8", document)
+}
+
+func (feedController *GeneralFeedController) GetSitemap(ctx *gin.Context) {
+	document, err := feedController.feedService.GetSitemap()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.Data(200, "application/xml; charset=utf-8", document)
+}
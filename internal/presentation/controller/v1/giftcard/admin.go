@@ -0,0 +1,147 @@
+package giftcard
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	giftcarddto "github.com/CosmeticsShiraz/Backend/internal/application/dto/giftcard"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
+	"github.com/gin-gonic/gin"
+)
+
+type AdminGiftCardController struct {
+	constants       *bootstrap.Constants
+	pagination      *bootstrap.Pagination
+	giftCardService usecase.GiftCardService
+}
+
+func NewAdminGiftCardController(
+	constants *bootstrap.Constants,
+	pagination *bootstrap.Pagination,
+	giftCardService usecase.GiftCardService,
+) *AdminGiftCardController {
+	return &AdminGiftCardController{
+		constants:       constants,
+		pagination:      pagination,
+		giftCardService: giftCardService,
+	}
+}
+
+func (giftCardController *AdminGiftCardController) GetAllGiftCardStatuses(ctx *gin.Context) {
+	statuses := giftCardController.giftCardService.GetAllGiftCardStatuses()
+	controller.Response(ctx, 200, "", statuses)
+}
+
+func (giftCardController *AdminGiftCardController) IssueGiftCard(ctx *gin.Context) {
+	type issueGiftCardParams struct {
+		Value          uint      `json:"value" validate:"required,gt=0"`
+		ExpiresAt      time.Time `json:"expiresAt" validate:"required"`
+		RecipientName  string    `json:"recipientName"`
+		RecipientPhone string    `json:"recipientPhone" validate:"required_without=RecipientEmail,omitempty,e164"`
+		RecipientEmail string    `json:"recipientEmail" validate:"required_without=RecipientPhone,omitempty,email"`
+	}
+	params := controller.Validated[issueGiftCardParams](ctx)
+	userID, _ := ctx.Get(giftCardController.constants.Context.ID)
+
+	trans := controller.GetTranslator(ctx, giftCardController.constants.Context.Translator)
+
+	templateFile := controller.GetLocalizedTemplateFile(ctx, giftCardController.constants.Context.Translator, giftCardController.constants.EmailTemplates.PersianFileName, giftCardController.constants.EmailTemplates.EnglishFileName)
+	emailSubject, _ := trans.Translate("emailSubject.giftCard")
+	issueRequest := giftcarddto.IssueGiftCardRequest{
+		IssuerID:       userID.(uint),
+		Value:          params.Value,
+		ExpiresAt:      params.ExpiresAt,
+		RecipientName:  params.RecipientName,
+		RecipientPhone: params.RecipientPhone,
+		RecipientEmail: params.RecipientEmail,
+		TemplateFile:   "gift_card/" + templateFile,
+		EmailSubject:   emailSubject,
+	}
+	giftCard, err := giftCardController.giftCardService.IssueGiftCard(issueRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	message, _ := trans.Translate("successMessage.issueGiftCard")
+	controller.Response(ctx, 200, message, giftCard)
+}
+
+func (giftCardController *AdminGiftCardController) ResendGiftCard(ctx *gin.Context) {
+	type resendGiftCardParams struct {
+		GiftCardID uint `uri:"giftCardID" validate:"required"`
+	}
+	params := controller.Validated[resendGiftCardParams](ctx)
+
+	trans := controller.GetTranslator(ctx, giftCardController.constants.Context.Translator)
+
+	templateFile := controller.GetLocalizedTemplateFile(ctx, giftCardController.constants.Context.Translator, giftCardController.constants.EmailTemplates.PersianFileName, giftCardController.constants.EmailTemplates.EnglishFileName)
+	emailSubject, _ := trans.Translate("emailSubject.giftCard")
+	resendRequest := giftcarddto.ResendGiftCardRequest{
+		GiftCardID:   params.GiftCardID,
+		TemplateFile: "gift_card/" + templateFile,
+		EmailSubject: emailSubject,
+	}
+	if err := giftCardController.giftCardService.ResendGiftCard(resendRequest); err != nil {
+		panic(err)
+	}
+
+	message, _ := trans.Translate("successMessage.resendGiftCard")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (giftCardController *AdminGiftCardController) GetGiftCards(ctx *gin.Context) {
+	type getGiftCardsParams struct {
+		Status uint `form:"status"`
+	}
+	params := controller.Validated[getGiftCardsParams](ctx)
+	pagination := controller.GetPagination(ctx, giftCardController.pagination.DefaultPage, giftCardController.pagination.DefaultPageSize)
+	offset, limit := pagination.GetOffsetLimit()
+
+	giftCardsRequest := giftcarddto.GetGiftCardsRequest{
+		Status: params.Status,
+		Offset: offset,
+		Limit:  limit,
+	}
+	giftCards, err := giftCardController.giftCardService.GetGiftCards(giftCardsRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", giftCards)
+}
+
+func (giftCardController *AdminGiftCardController) GetGiftCardTransactions(ctx *gin.Context) {
+	type getTransactionsParams struct {
+		GiftCardID uint `uri:"giftCardID" validate:"required"`
+	}
+	params := controller.Validated[getTransactionsParams](ctx)
+	pagination := controller.GetPagination(ctx, giftCardController.pagination.DefaultPage, giftCardController.pagination.DefaultPageSize)
+	offset, limit := pagination.GetOffsetLimit()
+
+	transactionsRequest := giftcarddto.GetGiftCardTransactionsRequest{
+		GiftCardID: params.GiftCardID,
+		Offset:     offset,
+		Limit:      limit,
+	}
+	transactions, err := giftCardController.giftCardService.GetGiftCardTransactions(transactionsRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", transactions)
+}
+
+func (giftCardController *AdminGiftCardController) DisableGiftCard(ctx *gin.Context) {
+	type disableGiftCardParams struct {
+		GiftCardID uint `uri:"giftCardID" validate:"required"`
+	}
+	params := controller.Validated[disableGiftCardParams](ctx)
+	if err := giftCardController.giftCardService.DisableGiftCard(params.GiftCardID); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, giftCardController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.disableGiftCard")
+	controller.Response(ctx, 200, message, nil)
+}
@@ -0,0 +1,42 @@
+package giftcard
+
+import (
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	giftcarddto "github.com/CosmeticsShiraz/Backend/internal/application/dto/giftcard"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerGiftCardController struct {
+	constants       *bootstrap.Constants
+	giftCardService usecase.GiftCardService
+}
+
+func NewCustomerGiftCardController(
+	constants *bootstrap.Constants,
+	giftCardService usecase.GiftCardService,
+) *CustomerGiftCardController {
+	return &CustomerGiftCardController{
+		constants:       constants,
+		giftCardService: giftCardService,
+	}
+}
+
+func (giftCardController *CustomerGiftCardController) GetGiftCardBalance(ctx *gin.Context) {
+	type giftCardBalanceParams struct {
+		Code string `json:"code" validate:"required"`
+	}
+	params := controller.Validated[giftCardBalanceParams](ctx)
+	userID, _ := ctx.Get(giftCardController.constants.Context.ID)
+
+	balanceRequest := giftcarddto.GetGiftCardBalanceRequest{
+		UserID: userID.(uint),
+		Code:   params.Code,
+	}
+	balance, err := giftCardController.giftCardService.GetGiftCardBalance(balanceRequest)
+	if err != nil {
+		panic(err)
+	}
+	controller.Response(ctx, 200, "", balance)
+}
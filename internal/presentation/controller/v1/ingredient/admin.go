@@ -0,0 +1,93 @@
+package ingredient
+
+import (
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	ingredientdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/ingredient"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
+	"github.com/gin-gonic/gin"
+)
+
+type AdminIngredientController struct {
+	constants         *bootstrap.Constants
+	ingredientService usecase.IngredientService
+}
+
+func NewAdminIngredientController(
+	constants *bootstrap.Constants,
+	ingredientService usecase.IngredientService,
+) *AdminIngredientController {
+	return &AdminIngredientController{
+		constants:         constants,
+		ingredientService: ingredientService,
+	}
+}
+
+func (ingredientController *AdminIngredientController) CreateIngredient(ctx *gin.Context) {
+	type createIngredientParams struct {
+		INCIName          string `json:"inciName" validate:"required"`
+		PersianName       string `json:"persianName"`
+		IsParaben         bool   `json:"isParaben"`
+		IsFragrance       bool   `json:"isFragrance"`
+		ComedogenicRating uint   `json:"comedogenicRating" validate:"max=5"`
+	}
+	params := controller.Validated[createIngredientParams](ctx)
+
+	ingredientRequest := ingredientdto.CreateIngredientRequest{
+		INCIName:          params.INCIName,
+		PersianName:       params.PersianName,
+		IsParaben:         params.IsParaben,
+		IsFragrance:       params.IsFragrance,
+		ComedogenicRating: params.ComedogenicRating,
+	}
+	ingredientID, err := ingredientController.ingredientService.CreateIngredient(ingredientRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, ingredientController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.createIngredient")
+	controller.Response(ctx, 200, message, ingredientID)
+}
+
+func (ingredientController *AdminIngredientController) UpdateIngredient(ctx *gin.Context) {
+	type updateIngredientParams struct {
+		IngredientID      uint    `uri:"ingredientID" validate:"required"`
+		INCIName          *string `json:"inciName" validate:"omitempty,min=1"`
+		PersianName       *string `json:"persianName"`
+		IsParaben         *bool   `json:"isParaben"`
+		IsFragrance       *bool   `json:"isFragrance"`
+		ComedogenicRating *uint   `json:"comedogenicRating" validate:"omitempty,max=5"`
+	}
+	params := controller.Validated[updateIngredientParams](ctx)
+
+	ingredientRequest := ingredientdto.UpdateIngredientRequest{
+		IngredientID:      params.IngredientID,
+		INCIName:          params.INCIName,
+		PersianName:       params.PersianName,
+		IsParaben:         params.IsParaben,
+		IsFragrance:       params.IsFragrance,
+		ComedogenicRating: params.ComedogenicRating,
+	}
+	if err := ingredientController.ingredientService.UpdateIngredient(ingredientRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, ingredientController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.updateIngredient")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (ingredientController *AdminIngredientController) DeleteIngredient(ctx *gin.Context) {
+	type deleteIngredientParams struct {
+		IngredientID uint `uri:"ingredientID" validate:"required"`
+	}
+	params := controller.Validated[deleteIngredientParams](ctx)
+	if err := ingredientController.ingredientService.DeleteIngredient(params.IngredientID); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, ingredientController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.deleteIngredient")
+	controller.Response(ctx, 200, message, nil)
+}
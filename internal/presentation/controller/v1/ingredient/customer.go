@@ -0,0 +1,75 @@
+package ingredient
+
+import (
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	ingredientdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/ingredient"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerIngredientController struct {
+	constants         *bootstrap.Constants
+	ingredientService usecase.IngredientService
+}
+
+func NewCustomerIngredientController(
+	constants *bootstrap.Constants,
+	ingredientService usecase.IngredientService,
+) *CustomerIngredientController {
+	return &CustomerIngredientController{
+		constants:         constants,
+		ingredientService: ingredientService,
+	}
+}
+
+func (ingredientController *CustomerIngredientController) GetSkinProfile(ctx *gin.Context) {
+	userID, _ := ctx.Get(ingredientController.constants.Context.ID)
+	skinProfile, err := ingredientController.ingredientService.GetSkinProfile(userID.(uint))
+	if err != nil {
+		panic(err)
+	}
+	controller.Response(ctx, 200, "", skinProfile)
+}
+
+func (ingredientController *CustomerIngredientController) UpdateSkinProfile(ctx *gin.Context) {
+	type updateSkinProfileParams struct {
+		SkinType   *uint  `json:"skinType"`
+		AllergyIDs []uint `json:"allergyIDs"`
+		Concerns   []uint `json:"concerns"`
+	}
+	params := controller.Validated[updateSkinProfileParams](ctx)
+	userID, _ := ctx.Get(ingredientController.constants.Context.ID)
+
+	skinProfileRequest := ingredientdto.UpdateSkinProfileRequest{
+		UserID:     userID.(uint),
+		SkinType:   params.SkinType,
+		AllergyIDs: params.AllergyIDs,
+		Concerns:   params.Concerns,
+	}
+	if err := ingredientController.ingredientService.UpdateSkinProfile(skinProfileRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, ingredientController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.updateSkinProfile")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (ingredientController *CustomerIngredientController) CheckIngredients(ctx *gin.Context) {
+	type checkIngredientsParams struct {
+		IngredientIDs []uint `json:"ingredientIDs" validate:"required"`
+	}
+	params := controller.Validated[checkIngredientsParams](ctx)
+	userID, _ := ctx.Get(ingredientController.constants.Context.ID)
+
+	checkRequest := ingredientdto.CheckIngredientsRequest{
+		UserID:        userID.(uint),
+		IngredientIDs: params.IngredientIDs,
+	}
+	suitability, err := ingredientController.ingredientService.CheckIngredients(checkRequest)
+	if err != nil {
+		panic(err)
+	}
+	controller.Response(ctx, 200, "", suitability)
+}
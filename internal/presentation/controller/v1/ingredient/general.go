@@ -0,0 +1,70 @@
+package ingredient
+
+import (
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	ingredientdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/ingredient"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
+	"github.com/gin-gonic/gin"
+)
+
+type GeneralIngredientController struct {
+	constants         *bootstrap.Constants
+	pagination        *bootstrap.Pagination
+	ingredientService usecase.IngredientService
+}
+
+func NewGeneralIngredientController(
+	constants *bootstrap.Constants,
+	pagination *bootstrap.Pagination,
+	ingredientService usecase.IngredientService,
+) *GeneralIngredientController {
+	return &GeneralIngredientController{
+		constants:         constants,
+		pagination:        pagination,
+		ingredientService: ingredientService,
+	}
+}
+
+func (ingredientController *GeneralIngredientController) GetIngredients(ctx *gin.Context) {
+	type getIngredientsParams struct {
+		Search string `form:"search"`
+	}
+	params := controller.Validated[getIngredientsParams](ctx)
+	pagination := controller.GetPagination(ctx, ingredientController.pagination.DefaultPage, ingredientController.pagination.DefaultPageSize)
+	offset, limit := pagination.GetOffsetLimit()
+
+	ingredientsRequest := ingredientdto.GetIngredientsRequest{
+		Search: params.Search,
+		Offset: offset,
+		Limit:  limit,
+	}
+	ingredients, err := ingredientController.ingredientService.GetIngredients(ingredientsRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", ingredients)
+}
+
+func (ingredientController *GeneralIngredientController) GetIngredient(ctx *gin.Context) {
+	type getIngredientParams struct {
+		IngredientID uint `uri:"ingredientID" validate:"required"`
+	}
+	params := controller.Validated[getIngredientParams](ctx)
+	ingredient, err := ingredientController.ingredientService.GetIngredient(params.IngredientID)
+	if err != nil {
+		panic(err)
+	}
+	controller.Response(ctx, 200, "", ingredient)
+}
+
+func (ingredientController *GeneralIngredientController) GetAllSkinTypes(ctx *gin.Context) {
+	skinTypes := ingredientController.ingredientService.GetAllSkinTypes()
+	controller.Response(ctx, 200, "", skinTypes)
+}
+
+func (ingredientController *GeneralIngredientController) GetAllSkinConcerns(ctx *gin.Context) {
+	concerns := ingredientController.ingredientService.GetAllSkinConcerns()
+	controller.Response(ctx, 200, "", concerns)
+}
@@ -2,6 +2,7 @@ package news
 
 import (
 	"mime/multipart"
+	"time"
 
 	"github.com/CosmeticsShiraz/Backend/bootstrap"
 	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
@@ -12,20 +13,35 @@ import (
 )
 
 type AdminNewsController struct {
-	constants   *bootstrap.Constants
-	pagination  *bootstrap.Pagination
-	newsService usecase.NewsService
+	constants          *bootstrap.Constants
+	pagination         *bootstrap.Pagination
+	newsService        usecase.NewsService
+	newsCommentService usecase.NewsCommentService
+	analyticsService   usecase.NewsAnalyticsService
+	revisionService    usecase.NewsRevisionService
+	previewService     usecase.NewsPreviewService
+	translationService usecase.NewsTranslationService
 }
 
 func NewAdminNewsController(
 	constants *bootstrap.Constants,
 	pagination *bootstrap.Pagination,
 	newsService usecase.NewsService,
+	newsCommentService usecase.NewsCommentService,
+	analyticsService usecase.NewsAnalyticsService,
+	revisionService usecase.NewsRevisionService,
+	previewService usecase.NewsPreviewService,
+	translationService usecase.NewsTranslationService,
 ) *AdminNewsController {
 	return &AdminNewsController{
-		constants:   constants,
-		pagination:  pagination,
-		newsService: newsService,
+		constants:          constants,
+		pagination:         pagination,
+		newsService:        newsService,
+		newsCommentService: newsCommentService,
+		analyticsService:   analyticsService,
+		revisionService:    revisionService,
+		previewService:     previewService,
+		translationService: translationService,
 	}
 }
 
@@ -64,24 +80,30 @@ func (newsController *AdminNewsController) CreateDraftNews(ctx *gin.Context) {
 
 func (newsController *AdminNewsController) EditNews(ctx *gin.Context) {
 	type editNewsParams struct {
-		NewsID      uint                  `uri:"newsID" validate:"required"`
-		Title       *string               `json:"title"`
-		Content     *string               `json:"content"`
-		Description *string               `json:"description"`
-		CoverImage  *multipart.FileHeader `form:"cover_image"`
-		Status      uint                  `json:"status"`
+		NewsID          uint                  `uri:"newsID" validate:"required"`
+		Title           *string               `json:"title"`
+		Content         *string               `json:"content"`
+		Description     *string               `json:"description"`
+		CoverImage      *multipart.FileHeader `form:"cover_image"`
+		Status          uint                  `json:"status"`
+		MetaTitle       *string               `json:"metaTitle" validate:"omitempty,max=70"`
+		MetaDescription *string               `json:"metaDescription" validate:"omitempty,max=160"`
+		OGImage         *multipart.FileHeader `form:"og_image"`
 	}
 	params := controller.Validated[editNewsParams](ctx)
 	authorID, _ := ctx.Get(newsController.constants.Context.ID)
 
 	finalizeNewsParams := newsdto.EditNewsRequest{
-		NewsID:      params.NewsID,
-		AuthorID:    authorID.(uint),
-		Title:       params.Title,
-		Content:     params.Content,
-		Description: params.Description,
-		CoverImage:  params.CoverImage,
-		Status:      params.Status,
+		NewsID:          params.NewsID,
+		AuthorID:        authorID.(uint),
+		Title:           params.Title,
+		Content:         params.Content,
+		Description:     params.Description,
+		CoverImage:      params.CoverImage,
+		Status:          params.Status,
+		MetaTitle:       params.MetaTitle,
+		MetaDescription: params.MetaDescription,
+		OGImage:         params.OGImage,
 	}
 	if err := newsController.newsService.EditNews(finalizeNewsParams); err != nil {
 		panic(err)
@@ -133,6 +155,30 @@ func (newsController *AdminNewsController) UnpublishNews(ctx *gin.Context) {
 	controller.Response(ctx, 200, message, nil)
 }
 
+func (newsController *AdminNewsController) ScheduleNews(ctx *gin.Context) {
+	type scheduleNewsParams struct {
+		NewsID      uint       `uri:"newsID" validate:"required"`
+		PublishAt   *time.Time `json:"publishAt"`
+		UnpublishAt *time.Time `json:"unpublishAt"`
+	}
+	params := controller.Validated[scheduleNewsParams](ctx)
+	authorID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	scheduleParams := newsdto.ScheduleNewsRequest{
+		NewsID:      params.NewsID,
+		AuthorID:    authorID.(uint),
+		PublishAt:   params.PublishAt,
+		UnpublishAt: params.UnpublishAt,
+	}
+	if err := newsController.newsService.ScheduleNews(scheduleParams); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.scheduleNews")
+	controller.Response(ctx, 200, message, nil)
+}
+
 func (newsController *AdminNewsController) GetNewsList(ctx *gin.Context) {
 	type getNewsParams struct {
 		Status uint `form:"status" validate:"required"`
@@ -252,3 +298,384 @@ func (newsController *AdminNewsController) GetNewsMedia(ctx *gin.Context) {
 
 	controller.Response(ctx, 200, "", media)
 }
+
+func (newsController *AdminNewsController) CreateNewsUpload(ctx *gin.Context) {
+	type createUploadParams struct {
+		NewsID      uint   `uri:"newsID" validate:"required"`
+		Purpose     uint   `json:"purpose" validate:"required,min=1,max=2"`
+		ContentType string `json:"contentType" validate:"required"`
+		Size        int64  `json:"size" validate:"required,min=1"`
+	}
+	params := controller.Validated[createUploadParams](ctx)
+	userID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	uploadParams := newsdto.CreateNewsUploadRequest{
+		NewsID:      params.NewsID,
+		AuthorID:    userID.(uint),
+		Purpose:     params.Purpose,
+		ContentType: params.ContentType,
+		Size:        params.Size,
+	}
+	upload, err := newsController.newsService.CreateNewsUpload(uploadParams)
+	if err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.createUpload")
+	controller.Response(ctx, 200, message, upload)
+}
+
+func (newsController *AdminNewsController) ConfirmNewsUpload(ctx *gin.Context) {
+	type confirmUploadParams struct {
+		NewsID   uint `uri:"newsID" validate:"required"`
+		UploadID uint `uri:"uploadID" validate:"required"`
+	}
+	params := controller.Validated[confirmUploadParams](ctx)
+	userID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	confirmParams := newsdto.ConfirmNewsUploadRequest{
+		NewsID:   params.NewsID,
+		AuthorID: userID.(uint),
+		UploadID: params.UploadID,
+	}
+	upload, err := newsController.newsService.ConfirmNewsUpload(confirmParams)
+	if err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.confirmUpload")
+	controller.Response(ctx, 200, message, upload)
+}
+
+func (newsController *AdminNewsController) CreateNewsCategory(ctx *gin.Context) {
+	type createCategoryParams struct {
+		Name string `json:"name" validate:"required,max=100"`
+	}
+	params := controller.Validated[createCategoryParams](ctx)
+
+	categoryRequest := newsdto.CreateNewsCategoryRequest{
+		Name: params.Name,
+	}
+	categoryID, err := newsController.newsService.CreateNewsCategory(categoryRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.createNewsCategory")
+	controller.Response(ctx, 200, message, categoryID)
+}
+
+func (newsController *AdminNewsController) UpdateNewsCategory(ctx *gin.Context) {
+	type updateCategoryParams struct {
+		CategoryID uint    `uri:"categoryID" validate:"required"`
+		Name       *string `json:"name" validate:"omitempty,min=1,max=100"`
+	}
+	params := controller.Validated[updateCategoryParams](ctx)
+
+	categoryRequest := newsdto.UpdateNewsCategoryRequest{
+		CategoryID: params.CategoryID,
+		Name:       params.Name,
+	}
+	if err := newsController.newsService.UpdateNewsCategory(categoryRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.updateNewsCategory")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (newsController *AdminNewsController) DeleteNewsCategory(ctx *gin.Context) {
+	type deleteCategoryParams struct {
+		CategoryID uint `uri:"categoryID" validate:"required"`
+	}
+	params := controller.Validated[deleteCategoryParams](ctx)
+	if err := newsController.newsService.DeleteNewsCategory(params.CategoryID); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.deleteNewsCategory")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (newsController *AdminNewsController) UpdateNewsTaxonomy(ctx *gin.Context) {
+	type updateTaxonomyParams struct {
+		NewsID      uint     `uri:"newsID" validate:"required"`
+		CategoryIDs []uint   `json:"categoryIDs"`
+		Tags        []string `json:"tags" validate:"dive,max=100"`
+	}
+	params := controller.Validated[updateTaxonomyParams](ctx)
+	authorID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	taxonomyRequest := newsdto.UpdateNewsTaxonomyRequest{
+		NewsID:      params.NewsID,
+		AuthorID:    authorID.(uint),
+		CategoryIDs: params.CategoryIDs,
+		Tags:        params.Tags,
+	}
+	if err := newsController.newsService.UpdateNewsTaxonomy(taxonomyRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.updateNewsTaxonomy")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (newsController *AdminNewsController) GetAllCommentStatuses(ctx *gin.Context) {
+	statuses := newsController.newsCommentService.GetAllCommentStatuses()
+	controller.Response(ctx, 200, "", statuses)
+}
+
+func (newsController *AdminNewsController) GetCommentQueue(ctx *gin.Context) {
+	type getCommentsParams struct {
+		Status uint `form:"status" validate:"required"`
+	}
+	params := controller.Validated[getCommentsParams](ctx)
+	pagination := controller.GetPagination(ctx, newsController.pagination.DefaultPage, newsController.pagination.DefaultPageSize)
+	offset, limit := pagination.GetOffsetLimit()
+
+	queueRequest := newsdto.GetCommentQueueRequest{
+		Status: params.Status,
+		Offset: offset,
+		Limit:  limit,
+	}
+	comments, err := newsController.newsCommentService.GetCommentQueue(queueRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", comments)
+}
+
+func (newsController *AdminNewsController) ReviewComment(ctx *gin.Context) {
+	type reviewCommentParams struct {
+		CommentID uint `uri:"commentID" validate:"required"`
+		Action    uint `json:"action" validate:"required,oneof=1 2 3"`
+	}
+	params := controller.Validated[reviewCommentParams](ctx)
+	reviewerID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	reviewRequest := newsdto.ReviewNewsCommentRequest{
+		CommentID:  params.CommentID,
+		ReviewerID: reviewerID.(uint),
+		Action:     params.Action,
+	}
+	if err := newsController.newsCommentService.ReviewComment(reviewRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.reviewComment")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (newsController *AdminNewsController) GetNewsRevisions(ctx *gin.Context) {
+	type getRevisionsParams struct {
+		NewsID uint `uri:"newsID" validate:"required"`
+	}
+	params := controller.Validated[getRevisionsParams](ctx)
+	pagination := controller.GetPagination(ctx, newsController.pagination.DefaultPage, newsController.pagination.DefaultPageSize)
+	offset, limit := pagination.GetOffsetLimit()
+
+	revisionsRequest := newsdto.GetNewsRevisionsRequest{
+		NewsID: params.NewsID,
+		Offset: offset,
+		Limit:  limit,
+	}
+	revisions, err := newsController.revisionService.GetNewsRevisions(revisionsRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", revisions)
+}
+
+func (newsController *AdminNewsController) CompareNewsRevisions(ctx *gin.Context) {
+	type compareRevisionsParams struct {
+		NewsID uint `uri:"newsID" validate:"required"`
+		From   uint `form:"from" validate:"required"`
+		To     uint `form:"to" validate:"required"`
+	}
+	params := controller.Validated[compareRevisionsParams](ctx)
+
+	compareRequest := newsdto.CompareNewsRevisionsRequest{
+		NewsID:         params.NewsID,
+		FromRevisionID: params.From,
+		ToRevisionID:   params.To,
+	}
+	diff, err := newsController.revisionService.CompareNewsRevisions(compareRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", diff)
+}
+
+func (newsController *AdminNewsController) RestoreNewsRevision(ctx *gin.Context) {
+	type restoreRevisionParams struct {
+		NewsID     uint `uri:"newsID" validate:"required"`
+		RevisionID uint `uri:"revisionID" validate:"required"`
+	}
+	params := controller.Validated[restoreRevisionParams](ctx)
+	authorID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	restoreRequest := newsdto.RestoreNewsRevisionRequest{
+		NewsID:     params.NewsID,
+		RevisionID: params.RevisionID,
+		AuthorID:   authorID.(uint),
+	}
+	if err := newsController.newsService.RestoreNewsRevision(restoreRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.restoreRevision")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (newsController *AdminNewsController) GetNewsAnalytics(ctx *gin.Context) {
+	type getAnalyticsParams struct {
+		NewsID uint `uri:"newsID" validate:"required"`
+		Days   int  `form:"days" validate:"omitempty,min=1,max=365"`
+	}
+	params := controller.Validated[getAnalyticsParams](ctx)
+
+	analyticsRequest := newsdto.GetNewsAnalyticsRequest{
+		NewsID: params.NewsID,
+		Days:   params.Days,
+	}
+	analytics, err := newsController.analyticsService.GetNewsAnalytics(analyticsRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", analytics)
+}
+
+func (newsController *AdminNewsController) CreateNewsPreview(ctx *gin.Context) {
+	type createPreviewParams struct {
+		NewsID         uint `uri:"newsID" validate:"required"`
+		ExpiresInHours int  `json:"expiresInHours" validate:"omitempty,min=1"`
+	}
+	params := controller.Validated[createPreviewParams](ctx)
+	authorID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	previewRequest := newsdto.CreateNewsPreviewRequest{
+		NewsID:         params.NewsID,
+		AuthorID:       authorID.(uint),
+		ExpiresInHours: params.ExpiresInHours,
+	}
+	preview, err := newsController.previewService.CreateNewsPreview(previewRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.createNewsPreview")
+	controller.Response(ctx, 200, message, preview)
+}
+
+func (newsController *AdminNewsController) GetNewsPreviews(ctx *gin.Context) {
+	type getPreviewsParams struct {
+		NewsID uint `uri:"newsID" validate:"required"`
+	}
+	params := controller.Validated[getPreviewsParams](ctx)
+
+	previews, err := newsController.previewService.GetNewsPreviews(params.NewsID)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", previews)
+}
+
+func (newsController *AdminNewsController) RevokeNewsPreview(ctx *gin.Context) {
+	type revokePreviewParams struct {
+		NewsID    uint `uri:"newsID" validate:"required"`
+		PreviewID uint `uri:"previewID" validate:"required"`
+	}
+	params := controller.Validated[revokePreviewParams](ctx)
+	authorID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	revokeRequest := newsdto.RevokeNewsPreviewRequest{
+		NewsID:    params.NewsID,
+		PreviewID: params.PreviewID,
+		AuthorID:  authorID.(uint),
+	}
+	if err := newsController.previewService.RevokeNewsPreview(revokeRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.revokeNewsPreview")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (newsController *AdminNewsController) GetNewsTranslations(ctx *gin.Context) {
+	type getTranslationsParams struct {
+		NewsID uint `uri:"newsID" validate:"required"`
+	}
+	params := controller.Validated[getTranslationsParams](ctx)
+
+	translations, err := newsController.translationService.GetNewsTranslations(params.NewsID)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", translations)
+}
+
+func (newsController *AdminNewsController) SaveNewsTranslation(ctx *gin.Context) {
+	type saveTranslationParams struct {
+		NewsID      uint   `uri:"newsID" validate:"required"`
+		Locale      string `uri:"locale" validate:"required"`
+		Title       string `json:"title" validate:"required"`
+		Description string `json:"description"`
+		Content     string `json:"content"`
+	}
+	params := controller.Validated[saveTranslationParams](ctx)
+	authorID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	translationRequest := newsdto.SaveNewsTranslationRequest{
+		NewsID:      params.NewsID,
+		AuthorID:    authorID.(uint),
+		Locale:      params.Locale,
+		Title:       params.Title,
+		Description: params.Description,
+		Content:     params.Content,
+	}
+	if err := newsController.translationService.SaveNewsTranslation(translationRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.saveNewsTranslation")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (newsController *AdminNewsController) DeleteNewsTranslation(ctx *gin.Context) {
+	type deleteTranslationParams struct {
+		NewsID uint   `uri:"newsID" validate:"required"`
+		Locale string `uri:"locale" validate:"required"`
+	}
+	params := controller.Validated[deleteTranslationParams](ctx)
+	authorID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	translationRequest := newsdto.DeleteNewsTranslationRequest{
+		NewsID:   params.NewsID,
+		AuthorID: authorID.(uint),
+		Locale:   params.Locale,
+	}
+	if err := newsController.translationService.DeleteNewsTranslation(translationRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.deleteNewsTranslation")
+	controller.Response(ctx, 200, message, nil)
+}
@@ -0,0 +1,76 @@
+package news
+
+import (
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerNewsController struct {
+	constants          *bootstrap.Constants
+	newsCommentService usecase.NewsCommentService
+}
+
+func NewCustomerNewsController(
+	constants *bootstrap.Constants,
+	newsCommentService usecase.NewsCommentService,
+) *CustomerNewsController {
+	return &CustomerNewsController{
+		constants:          constants,
+		newsCommentService: newsCommentService,
+	}
+}
+
+func (newsController *CustomerNewsController) CreateComment(ctx *gin.Context) {
+	type createCommentParams struct {
+		NewsID   uint   `uri:"newsID" validate:"required"`
+		ParentID *uint  `json:"parentID"`
+		Content  string `json:"content" validate:"required,max=2000"`
+	}
+	params := controller.Validated[createCommentParams](ctx)
+	userID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	commentRequest := newsdto.CreateNewsCommentRequest{
+		NewsID:   params.NewsID,
+		UserID:   userID.(uint),
+		ParentID: params.ParentID,
+		Content:  params.Content,
+	}
+	comment, err := newsController.newsCommentService.CreateComment(commentRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	messageKey := "successMessage.createComment"
+	if comment.Status == enum.CommentStatusPending.String() {
+		messageKey = "successMessage.createPendingComment"
+	}
+	message, _ := trans.Translate(messageKey)
+	controller.Response(ctx, 200, message, comment)
+}
+
+func (newsController *CustomerNewsController) FlagComment(ctx *gin.Context) {
+	type flagCommentParams struct {
+		CommentID uint   `uri:"commentID" validate:"required"`
+		Reason    string `json:"reason" validate:"max=255"`
+	}
+	params := controller.Validated[flagCommentParams](ctx)
+	userID, _ := ctx.Get(newsController.constants.Context.ID)
+
+	flagRequest := newsdto.FlagNewsCommentRequest{
+		CommentID: params.CommentID,
+		UserID:    userID.(uint),
+		Reason:    params.Reason,
+	}
+	if err := newsController.newsCommentService.FlagComment(flagRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.flagComment")
+	controller.Response(ctx, 200, message, nil)
+}
@@ -1,39 +1,68 @@
 package news
 
 import (
+	"net/url"
+	"strings"
+
 	"github.com/CosmeticsShiraz/Backend/bootstrap"
 	newsdto "github.com/CosmeticsShiraz/Backend/internal/application/dto/news"
 	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/logger"
 	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
 	"github.com/gin-gonic/gin"
 )
 
 type GeneralNewsController struct {
-	constants   *bootstrap.Constants
-	pagination  *bootstrap.Pagination
-	newsService usecase.NewsService
+	constants          *bootstrap.Constants
+	pagination         *bootstrap.Pagination
+	logger             logger.Logger
+	newsService        usecase.NewsService
+	newsCommentService usecase.NewsCommentService
+	analyticsService   usecase.NewsAnalyticsService
 }
 
 func NewGeneralNewsController(
 	constants *bootstrap.Constants,
 	pagination *bootstrap.Pagination,
+	logger logger.Logger,
 	newsService usecase.NewsService,
+	newsCommentService usecase.NewsCommentService,
+	analyticsService usecase.NewsAnalyticsService,
 ) *GeneralNewsController {
 	return &GeneralNewsController{
-		constants:   constants,
-		pagination:  pagination,
-		newsService: newsService,
+		constants:          constants,
+		pagination:         pagination,
+		logger:             logger,
+		newsService:        newsService,
+		newsCommentService: newsCommentService,
+		analyticsService:   analyticsService,
 	}
 }
 
+// contentLocale is the locale LocalizationMiddleware picked from Accept-Language.
+// It is echoed in Content-Language so caches keep the translations apart.
+func (newsController *GeneralNewsController) contentLocale(ctx *gin.Context) string {
+	trans := controller.GetTranslator(ctx, newsController.constants.Context.Translator)
+	ctx.Header("Vary", "Accept-Language")
+	return trans.Locale()
+}
+
 func (newsController *GeneralNewsController) GetNewsList(ctx *gin.Context) {
+	type getNewsListParams struct {
+		Category string `form:"category"`
+		Tag      string `form:"tag"`
+	}
+	params := controller.Validated[getNewsListParams](ctx)
 	pagination := controller.GetPagination(ctx, newsController.pagination.DefaultPage, newsController.pagination.DefaultPageSize)
 	offset, limit := pagination.GetOffsetLimit()
 
 	getNewsRequest := newsdto.GetPublicNewsListRequest{
-		Offset: offset,
-		Limit:  limit,
+		CategorySlug: params.Category,
+		TagSlug:      params.Tag,
+		Locale:       newsController.contentLocale(ctx),
+		Offset:       offset,
+		Limit:        limit,
 	}
 	news, err := newsController.newsService.GetPublicNewsList(getNewsRequest)
 	if err != nil {
@@ -45,11 +74,76 @@ func (newsController *GeneralNewsController) GetNewsList(ctx *gin.Context) {
 
 func (newsController *GeneralNewsController) GetNews(ctx *gin.Context) {
 	type getNewsParams struct {
-		NewsID uint `uri:"newsID" validate:"required"`
+		NewsID  uint   `uri:"newsID" validate:"required"`
+		Preview string `form:"preview"`
+	}
+	params := controller.Validated[getNewsParams](ctx)
+
+	getNewsRequest := newsdto.GetPublicNewsRequest{
+		NewsID:       params.NewsID,
+		PreviewToken: params.Preview,
+		Locale:       newsController.contentLocale(ctx),
+	}
+	news, err := newsController.newsService.GetPublicNews(getNewsRequest)
+	if err != nil {
+		panic(err)
+	}
+	ctx.Header("Content-Language", news.Locale)
+	if params.Preview != "" {
+		ctx.Header("X-Robots-Tag", "noindex, nofollow")
+	} else {
+		newsController.recordView(ctx, news.ID)
+	}
+
+	controller.Response(ctx, 200, "", news)
+}
+
+func (newsController *GeneralNewsController) GetNewsBySlug(ctx *gin.Context) {
+	type getNewsParams struct {
+		Slug string `uri:"slug" validate:"required"`
 	}
 	params := controller.Validated[getNewsParams](ctx)
 
-	news, err := newsController.newsService.GetPublicNews(params.NewsID)
+	getNewsRequest := newsdto.GetPublicNewsBySlugRequest{
+		Slug:   params.Slug,
+		Locale: newsController.contentLocale(ctx),
+	}
+	news, err := newsController.newsService.GetPublicNewsBySlug(getNewsRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	if news.Slug != params.Slug {
+		location := strings.Replace(ctx.FullPath(), ":slug", url.PathEscape(news.Slug), 1)
+		ctx.Redirect(301, location)
+		return
+	}
+	ctx.Header("Content-Language", news.Locale)
+	newsController.recordView(ctx, news.ID)
+
+	controller.Response(ctx, 200, "", news)
+}
+
+// recordView identifies visitors by the X-Visitor-ID header the clients keep per
+// device, falling back to their address and user agent. Counting is best-effort, so
+// a failure is logged rather than failing the response.
+func (newsController *GeneralNewsController) recordView(ctx *gin.Context, newsID uint) {
+	visitorID := ctx.GetHeader("X-Visitor-ID")
+	if visitorID == "" {
+		visitorID = ctx.ClientIP() + "|" + ctx.Request.UserAgent()
+	}
+
+	viewRequest := newsdto.RecordNewsViewRequest{
+		NewsID:    newsID,
+		VisitorID: visitorID,
+	}
+	if err := newsController.analyticsService.RecordNewsView(viewRequest); err != nil {
+		newsController.logger.Error("news view recording failed", logger.Error("error", err))
+	}
+}
+
+func (newsController *GeneralNewsController) GetMostReadNews(ctx *gin.Context) {
+	news, err := newsController.analyticsService.GetMostReadNews(newsController.contentLocale(ctx))
 	if err != nil {
 		panic(err)
 	}
@@ -59,15 +153,17 @@ func (newsController *GeneralNewsController) GetNews(ctx *gin.Context) {
 
 func (newsController *GeneralNewsController) GetNewsMedia(ctx *gin.Context) {
 	type getNewsParams struct {
-		NewsID  uint `uri:"newsID" validate:"required"`
-		MediaID uint `uri:"mediaID" validate:"required"`
+		NewsID  uint   `uri:"newsID" validate:"required"`
+		MediaID uint   `uri:"mediaID" validate:"required"`
+		Preview string `form:"preview"`
 	}
 	params := controller.Validated[getNewsParams](ctx)
 
 	mediaParams := newsdto.AccessMediaRequest{
-		NewsID:   params.NewsID,
-		MediaID:  params.MediaID,
-		UserType: enum.UserTypeGuest,
+		NewsID:       params.NewsID,
+		MediaID:      params.MediaID,
+		UserType:     enum.UserTypeGuest,
+		PreviewToken: params.Preview,
 	}
 	media, err := newsController.newsService.GetNewsMedia(mediaParams)
 	if err != nil {
@@ -76,3 +172,60 @@ func (newsController *GeneralNewsController) GetNewsMedia(ctx *gin.Context) {
 
 	controller.Response(ctx, 200, "", media)
 }
+
+func (newsController *GeneralNewsController) GetRelatedNews(ctx *gin.Context) {
+	type getNewsParams struct {
+		NewsID uint `uri:"newsID" validate:"required"`
+	}
+	params := controller.Validated[getNewsParams](ctx)
+
+	getNewsRequest := newsdto.GetRelatedNewsRequest{
+		NewsID: params.NewsID,
+		Locale: newsController.contentLocale(ctx),
+	}
+	news, err := newsController.newsService.GetRelatedNews(getNewsRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", news)
+}
+
+func (newsController *GeneralNewsController) GetNewsCategories(ctx *gin.Context) {
+	categories, err := newsController.newsService.GetNewsCategories()
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", categories)
+}
+
+func (newsController *GeneralNewsController) GetNewsTagCloud(ctx *gin.Context) {
+	tags, err := newsController.newsService.GetNewsTagCloud()
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", tags)
+}
+
+func (newsController *GeneralNewsController) GetNewsComments(ctx *gin.Context) {
+	type getCommentsParams struct {
+		NewsID uint `uri:"newsID" validate:"required"`
+	}
+	params := controller.Validated[getCommentsParams](ctx)
+	pagination := controller.GetPagination(ctx, newsController.pagination.DefaultPage, newsController.pagination.DefaultPageSize)
+	offset, limit := pagination.GetOffsetLimit()
+
+	commentsRequest := newsdto.GetNewsCommentsRequest{
+		NewsID: params.NewsID,
+		Offset: offset,
+		Limit:  limit,
+	}
+	comments, err := newsController.newsCommentService.GetNewsComments(commentsRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", comments)
+}
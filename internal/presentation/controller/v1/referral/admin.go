@@ -0,0 +1,82 @@
+package referral
+
+import (
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	referraldto "github.com/CosmeticsShiraz/Backend/internal/application/dto/referral"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
+	"github.com/gin-gonic/gin"
+)
+
+type AdminReferralController struct {
+	constants       *bootstrap.Constants
+	pagination      *bootstrap.Pagination
+	referralService usecase.ReferralService
+}
+
+func NewAdminReferralController(
+	constants *bootstrap.Constants,
+	pagination *bootstrap.Pagination,
+	referralService usecase.ReferralService,
+) *AdminReferralController {
+	return &AdminReferralController{
+		constants:       constants,
+		pagination:      pagination,
+		referralService: referralService,
+	}
+}
+
+func (referralController *AdminReferralController) GetAllReferralStatuses(ctx *gin.Context) {
+	statuses := referralController.referralService.GetAllReferralStatuses()
+	controller.Response(ctx, 200, "", statuses)
+}
+
+func (referralController *AdminReferralController) GetReferralReport(ctx *gin.Context) {
+	type getReferralsParams struct {
+		Status uint `form:"status"`
+	}
+	params := controller.Validated[getReferralsParams](ctx)
+	pagination := controller.GetPagination(ctx, referralController.pagination.DefaultPage, referralController.pagination.DefaultPageSize)
+	offset, limit := pagination.GetOffsetLimit()
+
+	reportRequest := referraldto.GetReferralReportRequest{
+		Status: params.Status,
+		Offset: offset,
+		Limit:  limit,
+	}
+	referrals, err := referralController.referralService.GetReferralReport(reportRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", referrals)
+}
+
+func (referralController *AdminReferralController) ReviewReferral(ctx *gin.Context) {
+	type reviewReferralParams struct {
+		ReferralID uint `uri:"referralID" validate:"required"`
+		Status     uint `json:"status" validate:"required,oneof=1 3"`
+	}
+	params := controller.Validated[reviewReferralParams](ctx)
+
+	reviewRequest := referraldto.ReviewReferralRequest{
+		ReferralID: params.ReferralID,
+		Status:     params.Status,
+	}
+	if err := referralController.referralService.ReviewReferral(reviewRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, referralController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.reviewReferral")
+	controller.Response(ctx, 200, message, nil)
+}
+
+func (referralController *AdminReferralController) GetReferralSummary(ctx *gin.Context) {
+	summary, err := referralController.referralService.GetReferralSummary()
+	if err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", summary)
+}
@@ -0,0 +1,32 @@
+package referral
+
+import (
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerReferralController struct {
+	constants       *bootstrap.Constants
+	referralService usecase.ReferralService
+}
+
+func NewCustomerReferralController(
+	constants *bootstrap.Constants,
+	referralService usecase.ReferralService,
+) *CustomerReferralController {
+	return &CustomerReferralController{
+		constants:       constants,
+		referralService: referralService,
+	}
+}
+
+func (referralController *CustomerReferralController) GetMyReferral(ctx *gin.Context) {
+	userID, _ := ctx.Get(referralController.constants.Context.ID)
+	referral, err := referralController.referralService.GetUserReferral(userID.(uint))
+	if err != nil {
+		panic(err)
+	}
+	controller.Response(ctx, 200, "", referral)
+}
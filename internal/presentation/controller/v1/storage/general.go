@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"io"
+	"strings"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	storagedto "github.com/CosmeticsShiraz/Backend/internal/application/dto/storage"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/exception"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller"
+	"github.com/gin-gonic/gin"
+)
+
+type GeneralStorageController struct {
+	constants      *bootstrap.Constants
+	uploadConfig   *bootstrap.Upload
+	storageService usecase.StorageService
+}
+
+func NewGeneralStorageController(
+	constants *bootstrap.Constants,
+	uploadConfig *bootstrap.Upload,
+	storageService usecase.StorageService,
+) *GeneralStorageController {
+	return &GeneralStorageController{
+		constants:      constants,
+		uploadConfig:   uploadConfig,
+		storageService: storageService,
+	}
+}
+
+func (storageController *GeneralStorageController) GetObject(ctx *gin.Context) {
+	type getObjectParams struct {
+		Bucket    string `uri:"bucket" validate:"required"`
+		Key       string `uri:"key" validate:"required"`
+		Expires   int64  `form:"expires" validate:"required"`
+		Signature string `form:"signature" validate:"required"`
+	}
+	params := controller.Validated[getObjectParams](ctx)
+
+	objectRequest := storagedto.GetSignedObjectRequest{
+		Bucket:    params.Bucket,
+		Key:       strings.TrimPrefix(params.Key, "/"),
+		Expires:   params.Expires,
+		Signature: params.Signature,
+	}
+	object, err := storageController.storageService.GetSignedObject(objectRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.Data(200, object.ContentType, object.Data)
+}
+
+func (storageController *GeneralStorageController) PutObject(ctx *gin.Context) {
+	type putObjectParams struct {
+		Bucket    string `uri:"bucket" validate:"required"`
+		Key       string `uri:"key" validate:"required"`
+		Expires   int64  `form:"expires" validate:"required"`
+		Signature string `form:"signature" validate:"required"`
+	}
+	params := controller.Validated[putObjectParams](ctx)
+
+	maxSize := int64(storageController.uploadConfig.MediaMaxSizeMB) << 20
+	body, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxSize+1))
+	if err != nil {
+		panic(err)
+	}
+	if int64(len(body)) > maxSize {
+		var validationErrors exception.ValidationErrors
+		validationErrors.Add(storageController.constants.Field.File, storageController.constants.Tag.FileTooLarge)
+		panic(validationErrors)
+	}
+
+	objectRequest := storagedto.PutSignedObjectRequest{
+		Bucket:      params.Bucket,
+		Key:         strings.TrimPrefix(params.Key, "/"),
+		Expires:     params.Expires,
+		Signature:   params.Signature,
+		ContentType: ctx.GetHeader("Content-Type"),
+		Body:        body,
+	}
+	if err := storageController.storageService.PutSignedObject(objectRequest); err != nil {
+		panic(err)
+	}
+
+	controller.Response(ctx, 200, "", nil)
+}
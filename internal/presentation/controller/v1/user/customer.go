@@ -105,6 +105,37 @@ func (userController *CustomerUserController) ResetPassword(ctx *gin.Context) {
 	controller.Response(ctx, 200, message, nil)
 }
 
+func (userController *CustomerUserController) GetNotificationPreferences(ctx *gin.Context) {
+	userID, _ := ctx.Get(userController.constants.Context.ID)
+	preferences, err := userController.userService.GetNotificationPreferences(userID.(uint))
+	if err != nil {
+		panic(err)
+	}
+	controller.Response(ctx, 200, "", preferences)
+}
+
+func (userController *CustomerUserController) UpdateNotificationPreferences(ctx *gin.Context) {
+	type notificationPreferencesParams struct {
+		CartReminderOptOut *bool `json:"cartReminderOptOut"`
+		StockAlertOptOut   *bool `json:"stockAlertOptOut"`
+	}
+	params := controller.Validated[notificationPreferencesParams](ctx)
+	userID, _ := ctx.Get(userController.constants.Context.ID)
+
+	preferencesRequest := userdto.UpdateNotificationPreferencesRequest{
+		UserID:             userID.(uint),
+		CartReminderOptOut: params.CartReminderOptOut,
+		StockAlertOptOut:   params.StockAlertOptOut,
+	}
+	if err := userController.userService.UpdateNotificationPreferences(preferencesRequest); err != nil {
+		panic(err)
+	}
+
+	trans := controller.GetTranslator(ctx, userController.constants.Context.Translator)
+	message, _ := trans.Translate("successMessage.updateNotificationPreferences")
+	controller.Response(ctx, 200, message, nil)
+}
+
 func (userController *CustomerUserController) UpdateProfile(ctx *gin.Context) {
 	type updateProfileParams struct {
 		FirstName    *string               `form:"firstName" validate:"omitempty"`
@@ -34,13 +34,18 @@ func (userController *GeneralUserController) BasicRegister(ctx *gin.Context) {
 		Password        string `json:"password" validate:"required"`
 		ConfirmPassword string `json:"confirmPassword" validate:"required,eqfield=Password"`
 		AcceptedTerms   bool   `json:"acceptedTerms" validate:"required,eq=true"`
+		ReferralCode    string `json:"referralCode"`
+		DeviceID        string `json:"deviceID"`
 	}
 	params := controller.Validated[registerParams](ctx)
 	registerInfo := userdto.BasicRegisterRequest{
-		FirstName: params.FirstName,
-		LastName:  params.LastName,
-		Phone:     params.Phone,
-		Password:  params.Password,
+		FirstName:    params.FirstName,
+		LastName:     params.LastName,
+		Phone:        params.Phone,
+		Password:     params.Password,
+		ReferralCode: params.ReferralCode,
+		DeviceID:     params.DeviceID,
+		ClientIP:     ctx.ClientIP(),
 	}
 	if err := userController.userService.Register(registerInfo); err != nil {
 		panic(err)
@@ -0,0 +1,40 @@
+package job
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/logger"
+)
+
+type NewsSchedulerJob struct {
+	newsConfig  *bootstrap.News
+	logger      logger.Logger
+	newsService usecase.NewsService
+}
+
+func NewNewsSchedulerJob(
+	newsConfig *bootstrap.News,
+	logger logger.Logger,
+	newsService usecase.NewsService,
+) *NewsSchedulerJob {
+	return &NewsSchedulerJob{
+		newsConfig:  newsConfig,
+		logger:      logger,
+		newsService: newsService,
+	}
+}
+
+func (schedulerJob *NewsSchedulerJob) Start() {
+	interval := time.Duration(schedulerJob.newsConfig.SchedulerIntervalSeconds) * time.Second
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := schedulerJob.newsService.ProcessScheduledNews(); err != nil {
+				schedulerJob.logger.Error("news scheduler failed", logger.Error("error", err))
+			}
+		}
+	}()
+}
@@ -0,0 +1,40 @@
+package job
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/logger"
+)
+
+type NewsViewFlushJob struct {
+	newsConfig           *bootstrap.News
+	logger               logger.Logger
+	newsAnalyticsService usecase.NewsAnalyticsService
+}
+
+func NewNewsViewFlushJob(
+	newsConfig *bootstrap.News,
+	logger logger.Logger,
+	newsAnalyticsService usecase.NewsAnalyticsService,
+) *NewsViewFlushJob {
+	return &NewsViewFlushJob{
+		newsConfig:           newsConfig,
+		logger:               logger,
+		newsAnalyticsService: newsAnalyticsService,
+	}
+}
+
+func (flushJob *NewsViewFlushJob) Start() {
+	interval := time.Duration(flushJob.newsConfig.ViewFlushSeconds) * time.Second
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := flushJob.newsAnalyticsService.FlushNewsViews(); err != nil {
+				flushJob.logger.Error("news view flush failed", logger.Error("error", err))
+			}
+		}
+	}()
+}
@@ -0,0 +1,40 @@
+package job
+
+import (
+	"time"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/logger"
+)
+
+type UploadCleanupJob struct {
+	uploadConfig  *bootstrap.Upload
+	logger        logger.Logger
+	uploadService usecase.UploadService
+}
+
+func NewUploadCleanupJob(
+	uploadConfig *bootstrap.Upload,
+	logger logger.Logger,
+	uploadService usecase.UploadService,
+) *UploadCleanupJob {
+	return &UploadCleanupJob{
+		uploadConfig:  uploadConfig,
+		logger:        logger,
+		uploadService: uploadService,
+	}
+}
+
+func (cleanupJob *UploadCleanupJob) Start() {
+	interval := time.Duration(cleanupJob.uploadConfig.CleanupIntervalMinutes) * time.Minute
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := cleanupJob.uploadService.CleanupExpiredUploads(); err != nil {
+				cleanupJob.logger.Error("upload cleanup failed", logger.Error("error", err))
+			}
+		}
+	}()
+}
@@ -2,6 +2,9 @@ package middleware
 
 import (
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/CosmeticsShiraz/Backend/bootstrap"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/localization"
@@ -21,7 +24,7 @@ func NewLocalization(constants *bootstrap.Constants, translator localization.Tra
 }
 
 func (lm LocalizationMiddleware) Localization(c *gin.Context) {
-	locale := getLocale(c.Request)
+	locale := getLocale(c.Request, lm.constants.Locales)
 
 	translatorInstance := lm.translator.GetTranslator(locale)
 	c.Set(lm.constants.Context.Translator, translatorInstance)
@@ -29,6 +32,47 @@ func (lm LocalizationMiddleware) Localization(c *gin.Context) {
 	c.Next()
 }
 
-func getLocale(request *http.Request) string {
-	return request.Header.Get("Accept-Language")
+// getLocale maps the most preferred supported language in Accept-Language to a
+// locale, so "en;q=0.8,fa" selects fa_IR and "en-US,en;q=0.9" selects en_US.
+// Languages with q=0 are refused and anything unsupported falls back to Persian.
+func getLocale(request *http.Request, locales bootstrap.Locales) string {
+	type languageRange struct {
+		language string
+		quality  float64
+	}
+
+	var ranges []languageRange
+	for _, part := range strings.Split(request.Header.Get("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(key) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				parsed = 0
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+		language, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+		ranges = append(ranges, languageRange{language: strings.ToLower(language), quality: quality})
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	for _, languageRange := range ranges {
+		switch languageRange.language {
+		case "fa":
+			return locales.Persian
+		case "en":
+			return locales.English
+		}
+	}
+	return locales.Persian
 }
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/CosmeticsShiraz/Backend/bootstrap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLocale(t *testing.T) {
+	locales := bootstrap.Locales{Persian: "fa_IR", English: "en_US"}
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+	}{
+		{name: "missing header", acceptLanguage: "", expected: "fa_IR"},
+		{name: "english", acceptLanguage: "en", expected: "en_US"},
+		{name: "persian", acceptLanguage: "fa-IR", expected: "fa_IR"},
+		{name: "region and underscore", acceptLanguage: "en_GB", expected: "en_US"},
+		{name: "first of equal weights", acceptLanguage: "en-US,fa;q=0.9", expected: "en_US"},
+		{name: "highest weight wins", acceptLanguage: "en;q=0.8,fa", expected: "fa_IR"},
+		{name: "weights out of order", acceptLanguage: "fa;q=0.3, en;q=0.7", expected: "en_US"},
+		{name: "skips unsupported languages", acceptLanguage: "de-DE,de;q=0.9,en;q=0.5", expected: "en_US"},
+		{name: "refused language", acceptLanguage: "en;q=0", expected: "fa_IR"},
+		{name: "malformed weight", acceptLanguage: "en;q=abc,fa;q=0.1", expected: "fa_IR"},
+		{name: "unsupported only", acceptLanguage: "de, fr;q=0.5", expected: "fa_IR"},
+		{name: "wildcard", acceptLanguage: "*", expected: "fa_IR"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest("GET", "/", nil)
+			request.Header.Set("Accept-Language", test.acceptLanguage)
+			assert.Equal(t, test.expected, getLocale(request, locales))
+		})
+	}
+}
@@ -55,6 +55,8 @@ func (recovery RecoveryMiddleware) handleRecoveredError(ctx *gin.Context, err er
 		handleNotFoundError(ctx, notFoundError, recovery.constants.Context.Translator)
 	} else if forbiddenError, ok := err.(exception.ForbiddenError); ok {
 		handleForbiddenError(ctx, forbiddenError, recovery.constants.Context.Translator)
+	} else if deliveryError, ok := err.(exception.DeliveryError); ok {
+		handleDeliveryError(ctx, deliveryError, recovery.constants.Context.Translator)
 	} else {
 		unhandledErrors(ctx, err, recovery.constants.Context.Translator)
 	}
@@ -152,6 +154,14 @@ func handleForbiddenError(ctx *gin.Context, forbiddenError exception.ForbiddenEr
 	controller.Response(ctx, 403, message, nil)
 }
 
+func handleDeliveryError(ctx *gin.Context, deliveryError exception.DeliveryError, transKey string) {
+	loggerImpl.GetLogger().Error("delivery failed", logger.Error("error", deliveryError))
+	trans := controller.GetTranslator(ctx, transKey)
+	itemName, _ := trans.Translate(deliveryError.Item)
+	message, _ := trans.Translate("errors.deliveryFailed", itemName)
+	controller.Response(ctx, 502, message, nil)
+}
+
 func unhandledErrors(ctx *gin.Context, err error, transKey string) {
 	loggerImpl.GetLogger().Error("unhandled error recovery middleware", logger.Error("error:", err))
 	trans := controller.GetTranslator(ctx, transKey)
@@ -51,16 +51,61 @@ func SetupAdminRoutes(routerGroup *gin.RouterGroup, app *wire.Application) {
 		news.GET("", app.Controllers.Admin.NewsController.GetNewsList)
 		news.GET(status, app.Controllers.Admin.NewsController.GetAllNewsStatuses)
 		news.DELETE("", app.Controllers.Admin.NewsController.DeleteNews)
+		news.POST("/categories", app.Controllers.Admin.NewsController.CreateNewsCategory)
+		news.PUT("/categories/:categoryID", app.Controllers.Admin.NewsController.UpdateNewsCategory)
+		news.DELETE("/categories/:categoryID", app.Controllers.Admin.NewsController.DeleteNewsCategory)
+		news.GET("/comments", app.Controllers.Admin.NewsController.GetCommentQueue)
+		news.GET("/comments"+status, app.Controllers.Admin.NewsController.GetAllCommentStatuses)
+		news.PUT("/comments/:commentID/review", app.Controllers.Admin.NewsController.ReviewComment)
 		newsSubgroup := news.Group("/:newsID")
 		{
 			newsSubgroup.GET("", app.Controllers.Admin.NewsController.GetNews)
 			newsSubgroup.PUT("", app.Controllers.Admin.NewsController.EditNews)
 			newsSubgroup.PUT("/publish", app.Controllers.Admin.NewsController.PublishNews)
 			newsSubgroup.PUT("unpublish", app.Controllers.Admin.NewsController.UnpublishNews)
+			newsSubgroup.PUT("/schedule", app.Controllers.Admin.NewsController.ScheduleNews)
+			newsSubgroup.PUT("/taxonomy", app.Controllers.Admin.NewsController.UpdateNewsTaxonomy)
+			newsSubgroup.GET("/analytics", app.Controllers.Admin.NewsController.GetNewsAnalytics)
+			newsSubgroup.POST("/previews", app.Controllers.Admin.NewsController.CreateNewsPreview)
+			newsSubgroup.GET("/previews", app.Controllers.Admin.NewsController.GetNewsPreviews)
+			newsSubgroup.DELETE("/previews/:previewID", app.Controllers.Admin.NewsController.RevokeNewsPreview)
+			newsSubgroup.GET("/translations", app.Controllers.Admin.NewsController.GetNewsTranslations)
+			newsSubgroup.PUT("/translations/:locale", app.Controllers.Admin.NewsController.SaveNewsTranslation)
+			newsSubgroup.DELETE("/translations/:locale", app.Controllers.Admin.NewsController.DeleteNewsTranslation)
+			newsSubgroup.GET("/revisions", app.Controllers.Admin.NewsController.GetNewsRevisions)
+			newsSubgroup.GET("/revisions/diff", app.Controllers.Admin.NewsController.CompareNewsRevisions)
+			newsSubgroup.PUT("/revisions/:revisionID/restore", app.Controllers.Admin.NewsController.RestoreNewsRevision)
 			newsSubgroup.POST("/media", app.Controllers.Admin.NewsController.AddNewsMedia)
 			newsSubgroup.DELETE("/media/:mediaID", app.Controllers.Admin.NewsController.DeleteNewsMedia)
 			newsSubgroup.GET("/media/:mediaID", app.Controllers.Admin.NewsController.GetNewsMedia)
+			newsSubgroup.POST("/uploads", app.Controllers.Admin.NewsController.CreateNewsUpload)
+			newsSubgroup.POST("/uploads/:uploadID/confirm", app.Controllers.Admin.NewsController.ConfirmNewsUpload)
 		}
 
 	}
+
+	referrals := routerGroup.Group("/referrals")
+	{
+		referrals.GET("", app.Controllers.Admin.ReferralController.GetReferralReport)
+		referrals.GET(status, app.Controllers.Admin.ReferralController.GetAllReferralStatuses)
+		referrals.GET("/summary", app.Controllers.Admin.ReferralController.GetReferralSummary)
+		referrals.PUT("/:referralID/review", app.Controllers.Admin.ReferralController.ReviewReferral)
+	}
+
+	giftCards := routerGroup.Group("/gift-cards")
+	{
+		giftCards.POST("", app.Controllers.Admin.GiftCardController.IssueGiftCard)
+		giftCards.GET("", app.Controllers.Admin.GiftCardController.GetGiftCards)
+		giftCards.GET(status, app.Controllers.Admin.GiftCardController.GetAllGiftCardStatuses)
+		giftCards.GET("/:giftCardID/transactions", app.Controllers.Admin.GiftCardController.GetGiftCardTransactions)
+		giftCards.PUT("/:giftCardID/disable", app.Controllers.Admin.GiftCardController.DisableGiftCard)
+		giftCards.POST("/:giftCardID/resend", app.Controllers.Admin.GiftCardController.ResendGiftCard)
+	}
+
+	ingredients := routerGroup.Group("/ingredients")
+	{
+		ingredients.POST("", app.Controllers.Admin.IngredientController.CreateIngredient)
+		ingredients.PUT("/:ingredientID", app.Controllers.Admin.IngredientController.UpdateIngredient)
+		ingredients.DELETE("/:ingredientID", app.Controllers.Admin.IngredientController.DeleteIngredient)
+	}
 }
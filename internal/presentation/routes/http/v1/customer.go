@@ -13,6 +13,11 @@ func SetupCustomerRoutes(routerGroup *gin.RouterGroup, app *wire.Application) {
 		profile.POST("/complete", app.Controllers.Customer.UserController.CompleteRegister)
 		profile.POST("/verify/email", app.Controllers.Customer.UserController.VerifyEmail)
 		profile.PUT("", app.Controllers.Customer.UserController.UpdateProfile)
+		profile.GET("/notifications", app.Controllers.Customer.UserController.GetNotificationPreferences)
+		profile.PUT("/notifications", app.Controllers.Customer.UserController.UpdateNotificationPreferences)
+		profile.GET("/skin", app.Controllers.Customer.IngredientController.GetSkinProfile)
+		profile.PUT("/skin", app.Controllers.Customer.IngredientController.UpdateSkinProfile)
+		profile.POST("/skin/check", app.Controllers.Customer.IngredientController.CheckIngredients)
 	}
 
 	addresses := routerGroup.Group("/address")
@@ -20,4 +25,20 @@ func SetupCustomerRoutes(routerGroup *gin.RouterGroup, app *wire.Application) {
 		addresses.POST("", app.Controllers.Customer.AddressController.CreateUserAddress)
 		addresses.GET("", app.Controllers.Customer.AddressController.GetCustomerAddresses)
 	}
+
+	referral := routerGroup.Group("/referral")
+	{
+		referral.GET("", app.Controllers.Customer.ReferralController.GetMyReferral)
+	}
+
+	giftCards := routerGroup.Group("/gift-cards")
+	{
+		giftCards.POST("/balance", app.Controllers.Customer.GiftCardController.GetGiftCardBalance)
+	}
+
+	news := routerGroup.Group("/news")
+	{
+		news.POST("/:newsID/comments", app.Controllers.Customer.NewsController.CreateComment)
+		news.POST("/comments/:commentID/flag", app.Controllers.Customer.NewsController.FlagComment)
+	}
 }
@@ -27,7 +27,31 @@ func SetupGeneralRoutes(routerGroup *gin.RouterGroup, app *wire.Application) {
 	news := routerGroup.Group("/news")
 	{
 		news.GET("", app.Controllers.General.NewsController.GetNewsList)
+		news.GET("/most-read", app.Controllers.General.NewsController.GetMostReadNews)
 		news.GET("/:newsID", app.Controllers.General.NewsController.GetNews)
+		news.GET("/slug/:slug", app.Controllers.General.NewsController.GetNewsBySlug)
+		news.GET("/:newsID/related", app.Controllers.General.NewsController.GetRelatedNews)
+		news.GET("/:newsID/comments", app.Controllers.General.NewsController.GetNewsComments)
+		news.GET("/categories", app.Controllers.General.NewsController.GetNewsCategories)
+		news.GET("/tags", app.Controllers.General.NewsController.GetNewsTagCloud)
 		news.GET("/:newsID/media/:mediaID", app.Controllers.General.NewsController.GetNewsMedia)
 	}
+
+	ingredients := routerGroup.Group("/ingredients")
+	{
+		ingredients.GET("", app.Controllers.General.IngredientController.GetIngredients)
+		ingredients.GET("/:ingredientID", app.Controllers.General.IngredientController.GetIngredient)
+	}
+
+	skinProfile := routerGroup.Group("/skin-profile")
+	{
+		skinProfile.GET("/types", app.Controllers.General.IngredientController.GetAllSkinTypes)
+		skinProfile.GET("/concerns", app.Controllers.General.IngredientController.GetAllSkinConcerns)
+	}
+
+	storage := routerGroup.Group("/storage")
+	{
+		storage.GET("/:bucket/*key", app.Controllers.General.StorageController.GetObject)
+		storage.PUT("/:bucket/*key", app.Controllers.General.StorageController.PutObject)
+	}
 }
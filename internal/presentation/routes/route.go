@@ -16,6 +16,7 @@ func Run(ginEngine *gin.Engine, app *wire.Application) {
 	ginEngine.Use(app.Middlewares.Prometheus.PrometheusMiddleware)
 
 	ginEngine.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	registerFeedRoutes(ginEngine, app)
 
 	v1 := ginEngine.Group("/v1")
 	registerGeneralRoutes(v1, app)
@@ -23,6 +24,12 @@ func Run(ginEngine *gin.Engine, app *wire.Application) {
 	registerAdminRoutes(v1, app)
 }
 
+func registerFeedRoutes(ginEngine *gin.Engine, app *wire.Application) {
+	ginEngine.GET("/feed/news.rss", app.Controllers.General.FeedController.GetNewsRSS)
+	ginEngine.GET("/feed/news.atom", app.Controllers.General.FeedController.GetNewsAtom)
+	ginEngine.GET("/sitemap.xml", app.Controllers.General.FeedController.GetSitemap)
+}
+
 func registerGeneralRoutes(v1 *gin.RouterGroup, app *wire.Application) {
 	httpv1.SetupGeneralRoutes(v1, app)
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/CosmeticsShiraz/Backend/internal/domain/enum"
+	"github.com/CosmeticsShiraz/Backend/internal/domain/s3"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -30,3 +31,29 @@ func (s *S3StorageMock) UploadObject(bucketType enum.BucketType, key string, fil
 	args := s.Called(bucketType, key, file)
 	return args.Error(0)
 }
+
+func (s *S3StorageMock) PutObject(bucketType enum.BucketType, key string, body []byte, contentType string) error {
+	args := s.Called(bucketType, key, body, contentType)
+	return args.Error(0)
+}
+
+func (s *S3StorageMock) GetObject(bucketType enum.BucketType, key string) ([]byte, error) {
+	args := s.Called(bucketType, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *S3StorageMock) HeadObject(bucketType enum.BucketType, key string) (*s3.ObjectInfo, error) {
+	args := s.Called(bucketType, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*s3.ObjectInfo), args.Error(1)
+}
+
+func (s *S3StorageMock) GetPresignedUploadURL(bucketType enum.BucketType, key, contentType string, size int64, expiration time.Duration) (string, error) {
+	args := s.Called(bucketType, key, contentType, size, expiration)
+	return args.String(0), args.Error(1)
+}
@@ -14,3 +14,8 @@ func (s *SMSServiceMock) SendOTP(receptor, token string) error {
 	args := s.Called(receptor, token)
 	return args.Error(0)
 }
+
+func (s *SMSServiceMock) SendGiftCard(receptor, code, value string) error {
+	args := s.Called(receptor, code, value)
+	return args.Error(0)
+}
@@ -29,6 +29,11 @@ func (u *UserRepositoryMock) FindUserByID(db database.Database, id uint) (*entit
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (u *UserRepositoryMock) FindUsersByIDs(db database.Database, ids []uint) ([]*entity.User, error) {
+	args := u.Called(db, ids)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
 func (u *UserRepositoryMock) FindUserByPhone(db database.Database, phone string) (*entity.User, error) {
 	args := u.Called(db, phone)
 	if args.Get(0) == nil {
@@ -45,6 +50,14 @@ func (u *UserRepositoryMock) FindUserByEmail(db database.Database, email string)
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (u *UserRepositoryMock) FindUserByReferralCode(db database.Database, code string) (*entity.User, error) {
+	args := u.Called(db, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
 func (u *UserRepositoryMock) CreateUser(db database.Database, user *entity.User) error {
 	args := u.Called(db, user)
 	return args.Error(0)
@@ -60,6 +73,16 @@ func (u *UserRepositoryMock) UpdateUser(db database.Database, user *entity.User)
 	return args.Error(0)
 }
 
+func (u *UserRepositoryMock) UpdateUserReferralCode(db database.Database, userID uint, code string) error {
+	args := u.Called(db, userID, code)
+	return args.Error(0)
+}
+
+func (u *UserRepositoryMock) AddUserRewardPoints(db database.Database, userID uint, points uint) error {
+	args := u.Called(db, userID, points)
+	return args.Error(0)
+}
+
 func (u *UserRepositoryMock) FindUserRoles(db database.Database, user *entity.User) error {
 	args := u.Called(db, user)
 	return args.Error(0)
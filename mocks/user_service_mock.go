@@ -28,6 +28,16 @@ func (s *UserServiceMock) GetUserByID(userID uint) (*entity.User, error) {
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (s *UserServiceMock) GetUserCredentials(userIDs []uint) (map[uint]userdto.CredentialResponse, error) {
+	args := s.Called(userIDs)
+	return args.Get(0).(map[uint]userdto.CredentialResponse), args.Error(1)
+}
+
+func (s *UserServiceMock) GetUsersByIDs(userIDs []uint) (map[uint]*entity.User, error) {
+	args := s.Called(userIDs)
+	return args.Get(0).(map[uint]*entity.User), args.Error(1)
+}
+
 func (s *UserServiceMock) GetUserCredential(userID uint) (userdto.CredentialResponse, error) {
 	args := s.Called(userID)
 	return args.Get(0).(userdto.CredentialResponse), args.Error(1)
@@ -103,6 +113,16 @@ func (s *UserServiceMock) UpdateProfile(profileInfo userdto.UpdateProfileRequest
 	return args.Error(0)
 }
 
+func (s *UserServiceMock) GetNotificationPreferences(userID uint) (userdto.NotificationPreferencesResponse, error) {
+	args := s.Called(userID)
+	return args.Get(0).(userdto.NotificationPreferencesResponse), args.Error(1)
+}
+
+func (s *UserServiceMock) UpdateNotificationPreferences(request userdto.UpdateNotificationPreferencesRequest) error {
+	args := s.Called(request)
+	return args.Error(0)
+}
+
 func (s *UserServiceMock) GetAllPermissions() ([]userdto.PermissionResponse, error) {
 	args := s.Called()
 	return args.Get(0).([]userdto.PermissionResponse), args.Error(1)
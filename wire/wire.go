@@ -8,25 +8,37 @@ import (
 	"github.com/CosmeticsShiraz/Backend/internal/application/service"
 	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/communication"
+	domainImaging "github.com/CosmeticsShiraz/Backend/internal/domain/imaging"
 	domainLogger "github.com/CosmeticsShiraz/Backend/internal/domain/logger"
 	domainMetrics "github.com/CosmeticsShiraz/Backend/internal/domain/metrics"
 	domainPostgres "github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
 	domainRedis "github.com/CosmeticsShiraz/Backend/internal/domain/repository/redis"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/s3"
+	domainSanitizer "github.com/CosmeticsShiraz/Backend/internal/domain/sanitizer"
+	domainScanner "github.com/CosmeticsShiraz/Backend/internal/domain/scanner"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/communication/email"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/communication/sms"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	infraImaging "github.com/CosmeticsShiraz/Backend/internal/infrastructure/imaging"
 	infraJWT "github.com/CosmeticsShiraz/Backend/internal/infrastructure/jwt"
 	infraLocalization "github.com/CosmeticsShiraz/Backend/internal/infrastructure/localization"
 	infraLogger "github.com/CosmeticsShiraz/Backend/internal/infrastructure/logger"
 	infraMetrics "github.com/CosmeticsShiraz/Backend/internal/infrastructure/metrics"
 	infraPostgres "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
 	infraRedis "github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/redis"
+	infraSanitizer "github.com/CosmeticsShiraz/Backend/internal/infrastructure/sanitizer"
+	infraScanner "github.com/CosmeticsShiraz/Backend/internal/infrastructure/scanner"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/seed"
 	infraStorage "github.com/CosmeticsShiraz/Backend/internal/infrastructure/storage"
 	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/address"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/feed"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/giftcard"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/ingredient"
 	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/news"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/referral"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/storage"
 	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/user"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/job"
 	"github.com/CosmeticsShiraz/Backend/internal/presentation/middleware"
 	"github.com/google/wire"
 )
@@ -44,10 +56,30 @@ var RepositoryProviderSet = wire.NewSet(
 	infraPostgres.NewAddressRepository,
 	infraRedis.NewUserCacheRepository,
 	infraPostgres.NewNewsRepository,
+	infraPostgres.NewReferralRepository,
+	infraPostgres.NewGiftCardRepository,
+	infraRedis.NewRateLimitCacheRepository,
+	infraPostgres.NewIngredientRepository,
+	infraPostgres.NewImageRenditionRepository,
+	infraPostgres.NewPendingUploadRepository,
+	infraRedis.NewLockCacheRepository,
+	infraPostgres.NewNewsCommentRepository,
+	infraRedis.NewFeedCacheRepository,
+	infraRedis.NewNewsViewCacheRepository,
 	wire.Bind(new(domainPostgres.UserRepository), new(*infraPostgres.UserRepository)),
 	wire.Bind(new(domainPostgres.AddressRepository), new(*infraPostgres.AddressRepository)),
 	wire.Bind(new(domainRedis.UserCacheRepository), new(*infraRedis.UserCacheRepository)),
 	wire.Bind(new(domainPostgres.NewsRepository), new(*infraPostgres.NewsRepository)),
+	wire.Bind(new(domainPostgres.ReferralRepository), new(*infraPostgres.ReferralRepository)),
+	wire.Bind(new(domainPostgres.GiftCardRepository), new(*infraPostgres.GiftCardRepository)),
+	wire.Bind(new(domainRedis.RateLimitCacheRepository), new(*infraRedis.RateLimitCacheRepository)),
+	wire.Bind(new(domainPostgres.IngredientRepository), new(*infraPostgres.IngredientRepository)),
+	wire.Bind(new(domainPostgres.ImageRenditionRepository), new(*infraPostgres.ImageRenditionRepository)),
+	wire.Bind(new(domainPostgres.PendingUploadRepository), new(*infraPostgres.PendingUploadRepository)),
+	wire.Bind(new(domainRedis.LockCacheRepository), new(*infraRedis.LockCacheRepository)),
+	wire.Bind(new(domainPostgres.NewsCommentRepository), new(*infraPostgres.NewsCommentRepository)),
+	wire.Bind(new(domainRedis.FeedCacheRepository), new(*infraRedis.FeedCacheRepository)),
+	wire.Bind(new(domainRedis.NewsViewCacheRepository), new(*infraRedis.NewsViewCacheRepository)),
 )
 
 var ServiceProviderSet = wire.NewSet(
@@ -59,6 +91,18 @@ var ServiceProviderSet = wire.NewSet(
 	service.NewJWTService,
 	service.NewAddressService,
 	service.NewNewsService,
+	service.NewNewsCommentService,
+	service.NewReferralService,
+	service.NewGiftCardService,
+	service.NewIngredientService,
+	service.NewImageService,
+	service.NewUploadService,
+	service.NewStorageService,
+	service.NewFeedService,
+	service.NewNewsAnalyticsService,
+	service.NewNewsRevisionService,
+	service.NewNewsPreviewService,
+	service.NewNewsTranslationService,
 	wire.Bind(new(usecase.UserService), new(*service.UserService)),
 	wire.Bind(new(usecase.OTPService), new(*service.OTPService)),
 	wire.Bind(new(communication.SMSService), new(*sms.SMSService)),
@@ -66,6 +110,18 @@ var ServiceProviderSet = wire.NewSet(
 	wire.Bind(new(usecase.JWTService), new(*service.JWTService)),
 	wire.Bind(new(usecase.AddressService), new(*service.AddressService)),
 	wire.Bind(new(usecase.NewsService), new(*service.NewsService)),
+	wire.Bind(new(usecase.NewsCommentService), new(*service.NewsCommentService)),
+	wire.Bind(new(usecase.ReferralService), new(*service.ReferralService)),
+	wire.Bind(new(usecase.GiftCardService), new(*service.GiftCardService)),
+	wire.Bind(new(usecase.IngredientService), new(*service.IngredientService)),
+	wire.Bind(new(usecase.ImageService), new(*service.ImageService)),
+	wire.Bind(new(usecase.UploadService), new(*service.UploadService)),
+	wire.Bind(new(usecase.StorageService), new(*service.StorageService)),
+	wire.Bind(new(usecase.FeedService), new(*service.FeedService)),
+	wire.Bind(new(usecase.NewsAnalyticsService), new(*service.NewsAnalyticsService)),
+	wire.Bind(new(usecase.NewsRevisionService), new(*service.NewsRevisionService)),
+	wire.Bind(new(usecase.NewsPreviewService), new(*service.NewsPreviewService)),
+	wire.Bind(new(usecase.NewsTranslationService), new(*service.NewsTranslationService)),
 )
 
 var AdapterProviderSet = wire.NewSet(
@@ -74,27 +130,45 @@ var AdapterProviderSet = wire.NewSet(
 	infraJWT.NewJWTKeyManager,
 	infraMetrics.NewPrometheusMetrics,
 	infraStorage.NewS3Storage,
+	infraStorage.NewLocalStorage,
+	infraStorage.NewObjectStorage,
+	infraImaging.NewImageProcessor,
+	infraScanner.NewNoopScanner,
+	infraSanitizer.NewAllowlistSanitizer,
 	wire.Bind(new(domainLogger.Logger), new(*infraLogger.Logger)),
 	wire.Bind(new(domainMetrics.MetricsClient), new(*infraMetrics.PrometheusMetrics)),
-	wire.Bind(new(s3.S3Storage), new(*infraStorage.S3Storage)),
+	wire.Bind(new(s3.SignedObjectStore), new(*infraStorage.LocalStorage)),
+	wire.Bind(new(domainImaging.ImageProcessor), new(*infraImaging.ImageProcessor)),
+	wire.Bind(new(domainScanner.MalwareScanner), new(*infraScanner.NoopScanner)),
+	wire.Bind(new(domainSanitizer.HTMLSanitizer), new(*infraSanitizer.AllowlistSanitizer)),
 )
 
 var GeneralControllerProviderSet = wire.NewSet(
 	user.NewGeneralUserController,
 	address.NewGeneralAddressController,
 	news.NewGeneralNewsController,
+	ingredient.NewGeneralIngredientController,
+	storage.NewGeneralStorageController,
+	feed.NewGeneralFeedController,
 	wire.Struct(new(GeneralControllers), "*"),
 )
 
 var CustomerControllerProviderSet = wire.NewSet(
 	user.NewCustomerUserController,
 	address.NewCustomerAddressController,
+	referral.NewCustomerReferralController,
+	giftcard.NewCustomerGiftCardController,
+	ingredient.NewCustomerIngredientController,
+	news.NewCustomerNewsController,
 	wire.Struct(new(CustomerControllers), "*"),
 )
 
 var AdminControllerProviderSet = wire.NewSet(
 	user.NewAdminUserController,
 	news.NewAdminNewsController,
+	referral.NewAdminReferralController,
+	giftcard.NewAdminGiftCardController,
+	ingredient.NewAdminIngredientController,
 	wire.Struct(new(AdminControllers), "*"),
 )
 
@@ -119,6 +193,13 @@ var SeederProviderSet = wire.NewSet(
 	wire.Struct(new(Seeds), "*"),
 )
 
+var JobProviderSet = wire.NewSet(
+	job.NewUploadCleanupJob,
+	job.NewNewsSchedulerJob,
+	job.NewNewsViewFlushJob,
+	wire.Struct(new(Jobs), "*"),
+)
+
 func ProvideConstants(container *bootstrap.Config) *bootstrap.Constants {
 	return container.Constants
 }
@@ -179,6 +260,30 @@ func ProvideSuperAdminCredential(container *bootstrap.Config) *bootstrap.AdminCr
 	return &container.Env.SuperAdmin
 }
 
+func ProvideReferralConfig(container *bootstrap.Config) *bootstrap.Referral {
+	return &container.Env.Referral
+}
+
+func ProvideGiftCardConfig(container *bootstrap.Config) *bootstrap.GiftCard {
+	return &container.Env.GiftCard
+}
+
+func ProvideImageProcessingConfig(container *bootstrap.Config) *bootstrap.ImageProcessing {
+	return &container.Env.ImageProcessing
+}
+
+func ProvideUploadConfig(container *bootstrap.Config) *bootstrap.Upload {
+	return &container.Env.Upload
+}
+
+func ProvideNewsConfig(container *bootstrap.Config) *bootstrap.News {
+	return &container.Env.News
+}
+
+func ProvideFeedConfig(container *bootstrap.Config) *bootstrap.Feed {
+	return &container.Env.Feed
+}
+
 var ProviderSet = wire.NewSet(
 	DatabaseProviderSet,
 	RepositoryProviderSet,
@@ -190,6 +295,7 @@ var ProviderSet = wire.NewSet(
 	ControllersProviderSet,
 	MiddlewareProviderSet,
 	SeederProviderSet,
+	JobProviderSet,
 	ProvideConstants,
 	ProvideLoggerConfig,
 	ProvideRateLimitConfig,
@@ -205,6 +311,12 @@ var ProviderSet = wire.NewSet(
 	ProvideStorageConfig,
 	ProvideEmailSenderAccount,
 	ProvideSuperAdminCredential,
+	ProvideReferralConfig,
+	ProvideGiftCardConfig,
+	ProvideImageProcessingConfig,
+	ProvideUploadConfig,
+	ProvideNewsConfig,
+	ProvideFeedConfig,
 )
 
 type Database struct {
@@ -213,40 +325,56 @@ type Database struct {
 }
 
 type GeneralControllers struct {
-	UserController         *user.GeneralUserController
-	AddressController      *address.GeneralAddressController
-	NewsController         *news.GeneralNewsController
+	UserController       *user.GeneralUserController
+	AddressController    *address.GeneralAddressController
+	NewsController       *news.GeneralNewsController
+	IngredientController *ingredient.GeneralIngredientController
+	StorageController    *storage.GeneralStorageController
+	FeedController       *feed.GeneralFeedController
 }
 
 type CustomerControllers struct {
-	UserController         *user.CustomerUserController
-	AddressController      *address.CustomerAddressController
+	UserController       *user.CustomerUserController
+	AddressController    *address.CustomerAddressController
+	ReferralController   *referral.CustomerReferralController
+	GiftCardController   *giftcard.CustomerGiftCardController
+	IngredientController *ingredient.CustomerIngredientController
+	NewsController       *news.CustomerNewsController
 }
 
 type AdminControllers struct {
-	UserController         *user.AdminUserController
-	NewsController         *news.AdminNewsController
+	UserController       *user.AdminUserController
+	NewsController       *news.AdminNewsController
+	ReferralController   *referral.AdminReferralController
+	GiftCardController   *giftcard.AdminGiftCardController
+	IngredientController *ingredient.AdminIngredientController
 }
 
 type Controllers struct {
-	General     *GeneralControllers
-	Customer    *CustomerControllers
-	Admin       *AdminControllers
+	General  *GeneralControllers
+	Customer *CustomerControllers
+	Admin    *AdminControllers
 }
 
 type Middlewares struct {
-	Authentication      *middleware.AuthMiddleware
-	CORS                *middleware.CORSMiddleware
-	Recovery            *middleware.RecoveryMiddleware
-	Localization        *middleware.LocalizationMiddleware
-	RateLimit           *middleware.RateLimitMiddleware
-	Logger              *middleware.LoggerMiddleware
-	Prometheus          *middleware.PrometheusMiddleware
+	Authentication *middleware.AuthMiddleware
+	CORS           *middleware.CORSMiddleware
+	Recovery       *middleware.RecoveryMiddleware
+	Localization   *middleware.LocalizationMiddleware
+	RateLimit      *middleware.RateLimitMiddleware
+	Logger         *middleware.LoggerMiddleware
+	Prometheus     *middleware.PrometheusMiddleware
 }
 
 type Seeds struct {
-	AddressSeeder          *seed.AddressSeeder
-	RoleSeeder             *seed.RoleSeeder
+	AddressSeeder *seed.AddressSeeder
+	RoleSeeder    *seed.RoleSeeder
+}
+
+type Jobs struct {
+	UploadCleanupJob *job.UploadCleanupJob
+	NewsSchedulerJob *job.NewsSchedulerJob
+	NewsViewFlushJob *job.NewsViewFlushJob
 }
 
 type Application struct {
@@ -254,6 +382,7 @@ type Application struct {
 	Controllers *Controllers
 	Middlewares *Middlewares
 	Seeds       *Seeds
+	Jobs        *Jobs
 }
 
 func NewApplication(
@@ -261,12 +390,14 @@ func NewApplication(
 	controllers *Controllers,
 	middlewares *Middlewares,
 	seeds *Seeds,
+	jobs *Jobs,
 ) *Application {
 	return &Application{
 		Database:    database,
 		Controllers: controllers,
 		Middlewares: middlewares,
 		Seeds:       seeds,
+		Jobs:        jobs,
 	}
 }
 
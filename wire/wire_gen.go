@@ -11,25 +11,37 @@ import (
 	"github.com/CosmeticsShiraz/Backend/internal/application/service"
 	"github.com/CosmeticsShiraz/Backend/internal/application/usecase"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/communication"
+	imaging2 "github.com/CosmeticsShiraz/Backend/internal/domain/imaging"
 	logger2 "github.com/CosmeticsShiraz/Backend/internal/domain/logger"
 	metrics2 "github.com/CosmeticsShiraz/Backend/internal/domain/metrics"
 	postgres2 "github.com/CosmeticsShiraz/Backend/internal/domain/repository/postgres"
 	redis2 "github.com/CosmeticsShiraz/Backend/internal/domain/repository/redis"
 	"github.com/CosmeticsShiraz/Backend/internal/domain/s3"
+	sanitizer2 "github.com/CosmeticsShiraz/Backend/internal/domain/sanitizer"
+	scanner2 "github.com/CosmeticsShiraz/Backend/internal/domain/scanner"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/communication/email"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/communication/sms"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/database"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/imaging"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/jwt"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/localization"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/logger"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/metrics"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/postgres"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/repository/redis"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/sanitizer"
+	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/scanner"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/seed"
 	"github.com/CosmeticsShiraz/Backend/internal/infrastructure/storage"
 	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/address"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/feed"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/giftcard"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/ingredient"
 	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/news"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/referral"
+	storage2 "github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/storage"
 	"github.com/CosmeticsShiraz/Backend/internal/presentation/controller/v1/user"
+	"github.com/CosmeticsShiraz/Backend/internal/presentation/job"
 	"github.com/CosmeticsShiraz/Backend/internal/presentation/middleware"
 	"github.com/google/wire"
 )
@@ -59,17 +71,37 @@ func InitializeApplication(container *bootstrap.Config) (*Application, error) {
 	emailTemplates := ProvideEmailTemplates(container)
 	emailService := email.NewEmailService(emailAccount, emailTemplates)
 	s3 := ProvideStorageConfig(container)
-	s3Storage := storage.NewS3Storage(constants, s3)
+	storageS3Storage := storage.NewS3Storage(constants, s3)
+	localStorage := storage.NewLocalStorage(s3)
+	s3Storage := storage.NewObjectStorage(constants, s3, storageS3Storage, localStorage)
+	imageProcessing := ProvideImageProcessingConfig(container)
+	bootstrapLogger := ProvideLoggerConfig(container)
+	loggerLogger, err := logger.NewLogger(bootstrapLogger, constants)
+	if err != nil {
+		return nil, err
+	}
+	imageProcessor := imaging.NewImageProcessor(imageProcessing)
+	imageRenditionRepository := postgres.NewImageRenditionRepository()
+	imageService := service.NewImageService(constants, imageProcessing, loggerLogger, imageProcessor, s3Storage, imageRenditionRepository, postgresDatabase)
+	upload := ProvideUploadConfig(container)
+	noopScanner := scanner.NewNoopScanner()
+	pendingUploadRepository := postgres.NewPendingUploadRepository()
+	uploadService := service.NewUploadService(constants, upload, noopScanner, s3Storage, pendingUploadRepository, postgresDatabase)
 	userRepository := postgres.NewUserRepository()
+	referralRepository := postgres.NewReferralRepository()
+	bootstrapReferral := ProvideReferralConfig(container)
 	userServiceDeps := service.UserServiceDeps{
 		Constants:           constants,
+		ReferralConfig:      bootstrapReferral,
 		OTPService:          otpService,
 		JWTService:          jwtService,
 		SMSService:          smsService,
 		EmailService:        emailService,
-		S3Storage:           s3Storage,
+		ImageService:        imageService,
+		UploadService:       uploadService,
 		UserRepository:      userRepository,
 		UserCacheRepository: userCacheRepository,
+		ReferralRepository:  referralRepository,
 		DB:                  postgresDatabase,
 	}
 	userService := service.NewUserService(userServiceDeps)
@@ -79,24 +111,67 @@ func InitializeApplication(container *bootstrap.Config) (*Application, error) {
 	generalAddressController := address.NewGeneralAddressController(constants, addressService)
 	pagination := ProvidePaginationConfig(container)
 	newsRepository := postgres.NewNewsRepository()
-	newsService := service.NewNewsService(constants, userService, s3Storage, newsRepository, postgresDatabase)
-	generalNewsController := news.NewGeneralNewsController(constants, pagination, newsService)
+	bootstrapNews := ProvideNewsConfig(container)
+	newsCommentRepository := postgres.NewNewsCommentRepository()
+	lockCacheRepository := redis.NewLockCacheRepository(redisDatabase)
+	allowlistSanitizer := sanitizer.NewAllowlistSanitizer()
+	bootstrapFeed := ProvideFeedConfig(container)
+	feedCacheRepository := redis.NewFeedCacheRepository(redisDatabase)
+	feedService := service.NewFeedService(constants, bootstrapFeed, newsRepository, feedCacheRepository, postgresDatabase)
+	newsRevisionService := service.NewNewsRevisionService(constants, userService, newsRepository, postgresDatabase)
+	newsPreviewService := service.NewNewsPreviewService(constants, bootstrapNews, userService, newsRepository, postgresDatabase)
+	newsService := service.NewNewsService(constants, bootstrapNews, userService, s3Storage, imageService, uploadService, newsRevisionService, newsPreviewService, allowlistSanitizer, feedService, newsRepository, pendingUploadRepository, newsCommentRepository, lockCacheRepository, postgresDatabase)
+	rateLimitCacheRepository := redis.NewRateLimitCacheRepository(redisDatabase)
+	bootstrapMetrics := ProvideMetrics(container)
+	prometheusMetrics := metrics.NewPrometheusMetrics(bootstrapMetrics)
+	newsCommentService := service.NewNewsCommentService(constants, bootstrapNews, userService, newsRepository, newsCommentRepository, rateLimitCacheRepository, prometheusMetrics, postgresDatabase)
+	newsViewCacheRepository := redis.NewNewsViewCacheRepository(redisDatabase)
+	newsTranslationService := service.NewNewsTranslationService(constants, userService, newsService, newsRepository, postgresDatabase)
+	newsAnalyticsService := service.NewNewsAnalyticsService(constants, bootstrapNews, newsService, newsRepository, newsCommentRepository, newsViewCacheRepository, prometheusMetrics, postgresDatabase)
+	generalNewsController := news.NewGeneralNewsController(constants, pagination, loggerLogger, newsService, newsCommentService, newsAnalyticsService)
+	ingredientRepository := postgres.NewIngredientRepository()
+	ingredientService := service.NewIngredientService(constants, userService, userRepository, ingredientRepository, postgresDatabase)
+	generalIngredientController := ingredient.NewGeneralIngredientController(constants, pagination, ingredientService)
+	storageService := service.NewStorageService(constants, localStorage)
+	generalStorageController := storage2.NewGeneralStorageController(constants, upload, storageService)
+	generalFeedController := feed.NewGeneralFeedController(feedService)
 	generalControllers := &GeneralControllers{
-		UserController:    generalUserController,
-		AddressController: generalAddressController,
-		NewsController:    generalNewsController,
+		UserController:       generalUserController,
+		AddressController:    generalAddressController,
+		NewsController:       generalNewsController,
+		IngredientController: generalIngredientController,
+		StorageController:    generalStorageController,
+		FeedController:       generalFeedController,
 	}
 	customerUserController := user.NewCustomerUserController(constants, userService)
 	customerAddressController := address.NewCustomerAddressController(constants, addressService)
+	referralService := service.NewReferralService(constants, bootstrapReferral, userService, userRepository, addressRepository, referralRepository, postgresDatabase)
+	customerReferralController := referral.NewCustomerReferralController(constants, referralService)
+	bootstrapGiftCard := ProvideGiftCardConfig(container)
+	giftCardRepository := postgres.NewGiftCardRepository()
+	giftCardService := service.NewGiftCardService(constants, bootstrapGiftCard, loggerLogger, userService, smsService, emailService, giftCardRepository, rateLimitCacheRepository, postgresDatabase)
+	customerGiftCardController := giftcard.NewCustomerGiftCardController(constants, giftCardService)
+	customerIngredientController := ingredient.NewCustomerIngredientController(constants, ingredientService)
+	customerNewsController := news.NewCustomerNewsController(constants, newsCommentService)
 	customerControllers := &CustomerControllers{
-		UserController:    customerUserController,
-		AddressController: customerAddressController,
+		UserController:       customerUserController,
+		AddressController:    customerAddressController,
+		ReferralController:   customerReferralController,
+		GiftCardController:   customerGiftCardController,
+		IngredientController: customerIngredientController,
+		NewsController:       customerNewsController,
 	}
 	adminUserController := user.NewAdminUserController(constants, pagination, userService)
-	adminNewsController := news.NewAdminNewsController(constants, pagination, newsService)
+	adminNewsController := news.NewAdminNewsController(constants, pagination, newsService, newsCommentService, newsAnalyticsService, newsRevisionService, newsPreviewService, newsTranslationService)
+	adminReferralController := referral.NewAdminReferralController(constants, pagination, referralService)
+	adminGiftCardController := giftcard.NewAdminGiftCardController(constants, pagination, giftCardService)
+	adminIngredientController := ingredient.NewAdminIngredientController(constants, ingredientService)
 	adminControllers := &AdminControllers{
-		UserController: adminUserController,
-		NewsController: adminNewsController,
+		UserController:       adminUserController,
+		NewsController:       adminNewsController,
+		ReferralController:   adminReferralController,
+		GiftCardController:   adminGiftCardController,
+		IngredientController: adminIngredientController,
 	}
 	controllers := &Controllers{
 		General:  generalControllers,
@@ -110,14 +185,7 @@ func InitializeApplication(container *bootstrap.Config) (*Application, error) {
 	localizationMiddleware := middleware.NewLocalization(constants, translator)
 	rateLimit := ProvideRateLimitConfig(container)
 	rateLimitMiddleware := middleware.NewRateLimit(rateLimit)
-	bootstrapLogger := ProvideLoggerConfig(container)
-	loggerLogger, err := logger.NewLogger(bootstrapLogger, constants)
-	if err != nil {
-		return nil, err
-	}
 	loggerMiddleware := middleware.NewLoggerMiddleware(loggerLogger)
-	bootstrapMetrics := ProvideMetrics(container)
-	prometheusMetrics := metrics.NewPrometheusMetrics(bootstrapMetrics)
 	prometheusMiddleware := middleware.NewPrometheusMiddleware(prometheusMetrics)
 	middlewares := &Middlewares{
 		Authentication: authMiddleware,
@@ -135,7 +203,15 @@ func InitializeApplication(container *bootstrap.Config) (*Application, error) {
 		AddressSeeder: addressSeeder,
 		RoleSeeder:    roleSeeder,
 	}
-	application := NewApplication(wireDatabase, controllers, middlewares, seeds)
+	uploadCleanupJob := job.NewUploadCleanupJob(upload, loggerLogger, uploadService)
+	newsSchedulerJob := job.NewNewsSchedulerJob(bootstrapNews, loggerLogger, newsService)
+	newsViewFlushJob := job.NewNewsViewFlushJob(bootstrapNews, loggerLogger, newsAnalyticsService)
+	jobs := &Jobs{
+		UploadCleanupJob: uploadCleanupJob,
+		NewsSchedulerJob: newsSchedulerJob,
+		NewsViewFlushJob: newsViewFlushJob,
+	}
+	application := NewApplication(wireDatabase, controllers, middlewares, seeds, jobs)
 	return application, nil
 }
 
@@ -143,17 +219,17 @@ func InitializeApplication(container *bootstrap.Config) (*Application, error) {
 
 var DatabaseProviderSet = wire.NewSet(database.NewPostgresDatabase, database.NewRedisDatabase, wire.Bind(new(database.Database), new(*database.PostgresDatabase)), wire.Bind(new(database.Cache), new(*database.RedisDatabase)), wire.Struct(new(Database), "*"))
 
-var RepositoryProviderSet = wire.NewSet(postgres.NewUserRepository, postgres.NewAddressRepository, redis.NewUserCacheRepository, postgres.NewNewsRepository, wire.Bind(new(postgres2.UserRepository), new(*postgres.UserRepository)), wire.Bind(new(postgres2.AddressRepository), new(*postgres.AddressRepository)), wire.Bind(new(redis2.UserCacheRepository), new(*redis.UserCacheRepository)), wire.Bind(new(postgres2.NewsRepository), new(*postgres.NewsRepository)))
+var RepositoryProviderSet = wire.NewSet(postgres.NewUserRepository, postgres.NewAddressRepository, redis.NewUserCacheRepository, postgres.NewNewsRepository, postgres.NewReferralRepository, postgres.NewGiftCardRepository, redis.NewRateLimitCacheRepository, postgres.NewIngredientRepository, postgres.NewImageRenditionRepository, postgres.NewPendingUploadRepository, redis.NewLockCacheRepository, postgres.NewNewsCommentRepository, redis.NewFeedCacheRepository, redis.NewNewsViewCacheRepository, wire.Bind(new(postgres2.UserRepository), new(*postgres.UserRepository)), wire.Bind(new(postgres2.AddressRepository), new(*postgres.AddressRepository)), wire.Bind(new(redis2.UserCacheRepository), new(*redis.UserCacheRepository)), wire.Bind(new(postgres2.NewsRepository), new(*postgres.NewsRepository)), wire.Bind(new(postgres2.ReferralRepository), new(*postgres.ReferralRepository)), wire.Bind(new(postgres2.GiftCardRepository), new(*postgres.GiftCardRepository)), wire.Bind(new(redis2.RateLimitCacheRepository), new(*redis.RateLimitCacheRepository)), wire.Bind(new(postgres2.IngredientRepository), new(*postgres.IngredientRepository)), wire.Bind(new(postgres2.ImageRenditionRepository), new(*postgres.ImageRenditionRepository)), wire.Bind(new(postgres2.PendingUploadRepository), new(*postgres.PendingUploadRepository)), wire.Bind(new(redis2.LockCacheRepository), new(*redis.LockCacheRepository)), wire.Bind(new(postgres2.NewsCommentRepository), new(*postgres.NewsCommentRepository)), wire.Bind(new(redis2.FeedCacheRepository), new(*redis.FeedCacheRepository)), wire.Bind(new(redis2.NewsViewCacheRepository), new(*redis.NewsViewCacheRepository)))
 
-var ServiceProviderSet = wire.NewSet(wire.Struct(new(service.UserServiceDeps), "*"), service.NewUserService, service.NewOTPService, sms.NewSMSService, email.NewEmailService, service.NewJWTService, service.NewAddressService, service.NewNewsService, wire.Bind(new(usecase.UserService), new(*service.UserService)), wire.Bind(new(usecase.OTPService), new(*service.OTPService)), wire.Bind(new(communication.SMSService), new(*sms.SMSService)), wire.Bind(new(communication.EmailService), new(*email.EmailService)), wire.Bind(new(usecase.JWTService), new(*service.JWTService)), wire.Bind(new(usecase.AddressService), new(*service.AddressService)), wire.Bind(new(usecase.NewsService), new(*service.NewsService)))
+var ServiceProviderSet = wire.NewSet(wire.Struct(new(service.UserServiceDeps), "*"), service.NewUserService, service.NewOTPService, sms.NewSMSService, email.NewEmailService, service.NewJWTService, service.NewAddressService, service.NewNewsService, service.NewNewsCommentService, service.NewReferralService, service.NewGiftCardService, service.NewIngredientService, service.NewImageService, service.NewUploadService, service.NewStorageService, service.NewFeedService, service.NewNewsAnalyticsService, service.NewNewsRevisionService, service.NewNewsPreviewService, service.NewNewsTranslationService, wire.Bind(new(usecase.UserService), new(*service.UserService)), wire.Bind(new(usecase.OTPService), new(*service.OTPService)), wire.Bind(new(communication.SMSService), new(*sms.SMSService)), wire.Bind(new(communication.EmailService), new(*email.EmailService)), wire.Bind(new(usecase.JWTService), new(*service.JWTService)), wire.Bind(new(usecase.AddressService), new(*service.AddressService)), wire.Bind(new(usecase.NewsService), new(*service.NewsService)), wire.Bind(new(usecase.NewsCommentService), new(*service.NewsCommentService)), wire.Bind(new(usecase.ReferralService), new(*service.ReferralService)), wire.Bind(new(usecase.GiftCardService), new(*service.GiftCardService)), wire.Bind(new(usecase.IngredientService), new(*service.IngredientService)), wire.Bind(new(usecase.ImageService), new(*service.ImageService)), wire.Bind(new(usecase.UploadService), new(*service.UploadService)), wire.Bind(new(usecase.StorageService), new(*service.StorageService)), wire.Bind(new(usecase.FeedService), new(*service.FeedService)), wire.Bind(new(usecase.NewsAnalyticsService), new(*service.NewsAnalyticsService)), wire.Bind(new(usecase.NewsRevisionService), new(*service.NewsRevisionService)), wire.Bind(new(usecase.NewsPreviewService), new(*service.NewsPreviewService)), wire.Bind(new(usecase.NewsTranslationService), new(*service.NewsTranslationService)))
 
-var AdapterProviderSet = wire.NewSet(localization.NewTranslationService, logger.NewLogger, jwt.NewJWTKeyManager, metrics.NewPrometheusMetrics, storage.NewS3Storage, wire.Bind(new(logger2.Logger), new(*logger.Logger)), wire.Bind(new(metrics2.MetricsClient), new(*metrics.PrometheusMetrics)), wire.Bind(new(s3.S3Storage), new(*storage.S3Storage)))
+var AdapterProviderSet = wire.NewSet(localization.NewTranslationService, logger.NewLogger, jwt.NewJWTKeyManager, metrics.NewPrometheusMetrics, storage.NewS3Storage, storage.NewLocalStorage, storage.NewObjectStorage, imaging.NewImageProcessor, scanner.NewNoopScanner, sanitizer.NewAllowlistSanitizer, wire.Bind(new(logger2.Logger), new(*logger.Logger)), wire.Bind(new(metrics2.MetricsClient), new(*metrics.PrometheusMetrics)), wire.Bind(new(s3.SignedObjectStore), new(*storage.LocalStorage)), wire.Bind(new(imaging2.ImageProcessor), new(*imaging.ImageProcessor)), wire.Bind(new(scanner2.MalwareScanner), new(*scanner.NoopScanner)), wire.Bind(new(sanitizer2.HTMLSanitizer), new(*sanitizer.AllowlistSanitizer)))
 
-var GeneralControllerProviderSet = wire.NewSet(user.NewGeneralUserController, address.NewGeneralAddressController, news.NewGeneralNewsController, wire.Struct(new(GeneralControllers), "*"))
+var GeneralControllerProviderSet = wire.NewSet(user.NewGeneralUserController, address.NewGeneralAddressController, news.NewGeneralNewsController, ingredient.NewGeneralIngredientController, storage2.NewGeneralStorageController, feed.NewGeneralFeedController, wire.Struct(new(GeneralControllers), "*"))
 
-var CustomerControllerProviderSet = wire.NewSet(user.NewCustomerUserController, address.NewCustomerAddressController, wire.Struct(new(CustomerControllers), "*"))
+var CustomerControllerProviderSet = wire.NewSet(user.NewCustomerUserController, address.NewCustomerAddressController, referral.NewCustomerReferralController, giftcard.NewCustomerGiftCardController, ingredient.NewCustomerIngredientController, news.NewCustomerNewsController, wire.Struct(new(CustomerControllers), "*"))
 
-var AdminControllerProviderSet = wire.NewSet(user.NewAdminUserController, news.NewAdminNewsController, wire.Struct(new(AdminControllers), "*"))
+var AdminControllerProviderSet = wire.NewSet(user.NewAdminUserController, news.NewAdminNewsController, referral.NewAdminReferralController, giftcard.NewAdminGiftCardController, ingredient.NewAdminIngredientController, wire.Struct(new(AdminControllers), "*"))
 
 var ControllersProviderSet = wire.NewSet(wire.Struct(new(Controllers), "*"))
 
@@ -161,6 +237,8 @@ var MiddlewareProviderSet = wire.NewSet(middleware.NewAuthMiddleware, middleware
 
 var SeederProviderSet = wire.NewSet(seed.NewAddressSeeder, seed.NewRoleSeeder, wire.Struct(new(Seeds), "*"))
 
+var JobProviderSet = wire.NewSet(job.NewUploadCleanupJob, job.NewNewsSchedulerJob, job.NewNewsViewFlushJob, wire.Struct(new(Jobs), "*"))
+
 func ProvideConstants(container *bootstrap.Config) *bootstrap.Constants {
 	return container.Constants
 }
@@ -221,6 +299,30 @@ func ProvideSuperAdminCredential(container *bootstrap.Config) *bootstrap.AdminCr
 	return &container.Env.SuperAdmin
 }
 
+func ProvideReferralConfig(container *bootstrap.Config) *bootstrap.Referral {
+	return &container.Env.Referral
+}
+
+func ProvideGiftCardConfig(container *bootstrap.Config) *bootstrap.GiftCard {
+	return &container.Env.GiftCard
+}
+
+func ProvideImageProcessingConfig(container *bootstrap.Config) *bootstrap.ImageProcessing {
+	return &container.Env.ImageProcessing
+}
+
+func ProvideUploadConfig(container *bootstrap.Config) *bootstrap.Upload {
+	return &container.Env.Upload
+}
+
+func ProvideNewsConfig(container *bootstrap.Config) *bootstrap.News {
+	return &container.Env.News
+}
+
+func ProvideFeedConfig(container *bootstrap.Config) *bootstrap.Feed {
+	return &container.Env.Feed
+}
+
 var ProviderSet = wire.NewSet(
 	DatabaseProviderSet,
 	RepositoryProviderSet,
@@ -232,6 +334,7 @@ var ProviderSet = wire.NewSet(
 	ControllersProviderSet,
 	MiddlewareProviderSet,
 	SeederProviderSet,
+	JobProviderSet,
 	ProvideConstants,
 	ProvideLoggerConfig,
 	ProvideRateLimitConfig,
@@ -247,6 +350,12 @@ var ProviderSet = wire.NewSet(
 	ProvideStorageConfig,
 	ProvideEmailSenderAccount,
 	ProvideSuperAdminCredential,
+	ProvideReferralConfig,
+	ProvideGiftCardConfig,
+	ProvideImageProcessingConfig,
+	ProvideUploadConfig,
+	ProvideNewsConfig,
+	ProvideFeedConfig,
 )
 
 type Database struct {
@@ -255,19 +364,29 @@ type Database struct {
 }
 
 type GeneralControllers struct {
-	UserController    *user.GeneralUserController
-	AddressController *address.GeneralAddressController
-	NewsController    *news.GeneralNewsController
+	UserController       *user.GeneralUserController
+	AddressController    *address.GeneralAddressController
+	NewsController       *news.GeneralNewsController
+	IngredientController *ingredient.GeneralIngredientController
+	StorageController    *storage2.GeneralStorageController
+	FeedController       *feed.GeneralFeedController
 }
 
 type CustomerControllers struct {
-	UserController    *user.CustomerUserController
-	AddressController *address.CustomerAddressController
+	UserController       *user.CustomerUserController
+	AddressController    *address.CustomerAddressController
+	ReferralController   *referral.CustomerReferralController
+	GiftCardController   *giftcard.CustomerGiftCardController
+	IngredientController *ingredient.CustomerIngredientController
+	NewsController       *news.CustomerNewsController
 }
 
 type AdminControllers struct {
-	UserController *user.AdminUserController
-	NewsController *news.AdminNewsController
+	UserController       *user.AdminUserController
+	NewsController       *news.AdminNewsController
+	ReferralController   *referral.AdminReferralController
+	GiftCardController   *giftcard.AdminGiftCardController
+	IngredientController *ingredient.AdminIngredientController
 }
 
 type Controllers struct {
@@ -291,22 +410,31 @@ type Seeds struct {
 	RoleSeeder    *seed.RoleSeeder
 }
 
+type Jobs struct {
+	UploadCleanupJob *job.UploadCleanupJob
+	NewsSchedulerJob *job.NewsSchedulerJob
+	NewsViewFlushJob *job.NewsViewFlushJob
+}
+
 type Application struct {
 	Database    *Database
 	Controllers *Controllers
 	Middlewares *Middlewares
 	Seeds       *Seeds
+	Jobs        *Jobs
 }
 
 func NewApplication(database2 *Database,
 	controllers *Controllers,
 	middlewares *Middlewares,
 	seeds *Seeds,
+	jobs *Jobs,
 ) *Application {
 	return &Application{
 		Database:    database2,
 		Controllers: controllers,
 		Middlewares: middlewares,
 		Seeds:       seeds,
+		Jobs:        jobs,
 	}
 }